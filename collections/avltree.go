@@ -0,0 +1,298 @@
+package collections
+
+// avlNode represents a single node in an AVL tree.
+type avlNode[T any] struct {
+	value  T
+	left   *avlNode[T]
+	right  *avlNode[T]
+	height int // Height of the subtree rooted at this node (leaf = 1)
+}
+
+// AVLTree represents a self-balancing binary search tree with generic type support.
+// After every insertion or deletion, rotations keep the heights of sibling subtrees
+// within one of each other, so the tree height stays O(log n) even for sorted input.
+// Values are unique: inserting a value equal to an existing one is a no-op.
+type AVLTree[T any] struct {
+	root *avlNode[T]
+	less func(a, b T) bool
+	size int
+}
+
+// NewAVLTree creates and returns a new empty AVL tree ordered by less.
+func NewAVLTree[T any](less func(a, b T) bool) *AVLTree[T] {
+	return &AVLTree[T]{
+		root: nil,
+		less: less,
+		size: 0,
+	}
+}
+
+// Insert adds value to the tree and returns true, or returns false if it was already present.
+// Time complexity: O(log n)
+func (t *AVLTree[T]) Insert(value T) bool {
+	var inserted bool
+	t.root, inserted = t.insert(t.root, value)
+	if inserted {
+		t.size++
+	}
+	return inserted
+}
+
+// Delete removes value from the tree and returns true if it was present.
+// Time complexity: O(log n)
+func (t *AVLTree[T]) Delete(value T) bool {
+	var deleted bool
+	t.root, deleted = t.delete(t.root, value)
+	if deleted {
+		t.size--
+	}
+	return deleted
+}
+
+// Contains checks if the tree contains the specified value.
+// Time complexity: O(log n)
+func (t *AVLTree[T]) Contains(value T) bool {
+	current := t.root
+	for current != nil {
+		switch {
+		case t.less(value, current.value):
+			current = current.left
+		case t.less(current.value, value):
+			current = current.right
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+// Min returns the smallest value in the tree.
+// Returns the zero value and false if the tree is empty.
+// Time complexity: O(log n)
+func (t *AVLTree[T]) Min() (T, bool) {
+	if t.root == nil {
+		var zero T
+		return zero, false
+	}
+
+	node := t.root
+	for node.left != nil {
+		node = node.left
+	}
+	return node.value, true
+}
+
+// Max returns the largest value in the tree.
+// Returns the zero value and false if the tree is empty.
+// Time complexity: O(log n)
+func (t *AVLTree[T]) Max() (T, bool) {
+	if t.root == nil {
+		var zero T
+		return zero, false
+	}
+
+	node := t.root
+	for node.right != nil {
+		node = node.right
+	}
+	return node.value, true
+}
+
+// Successor returns the smallest value in the tree strictly greater than value.
+// value itself need not be in the tree. Returns the zero value and false if no larger
+// value exists.
+// Time complexity: O(log n)
+func (t *AVLTree[T]) Successor(value T) (T, bool) {
+	var result T
+	found := false
+
+	current := t.root
+	for current != nil {
+		if t.less(value, current.value) {
+			// Candidate: look left for a smaller one that is still greater than value
+			result, found = current.value, true
+			current = current.left
+		} else {
+			current = current.right
+		}
+	}
+
+	return result, found
+}
+
+// Predecessor returns the largest value in the tree strictly less than value.
+// value itself need not be in the tree. Returns the zero value and false if no smaller
+// value exists.
+// Time complexity: O(log n)
+func (t *AVLTree[T]) Predecessor(value T) (T, bool) {
+	var result T
+	found := false
+
+	current := t.root
+	for current != nil {
+		if t.less(current.value, value) {
+			// Candidate: look right for a larger one that is still less than value
+			result, found = current.value, true
+			current = current.right
+		} else {
+			current = current.left
+		}
+	}
+
+	return result, found
+}
+
+// InOrder returns the values of the tree in ascending order.
+// Time complexity: O(n)
+func (t *AVLTree[T]) InOrder() []T {
+	result := make([]T, 0, t.size)
+	stack := NewStack[*avlNode[T]]()
+	current := t.root
+
+	for current != nil || !stack.IsEmpty() {
+		for current != nil {
+			stack.Push(current)
+			current = current.left
+		}
+
+		node, _ := stack.Pop()
+		result = append(result, node.value)
+		current = node.right
+	}
+
+	return result
+}
+
+// Height returns the height of the tree (0 for an empty tree, 1 for a single node).
+// Time complexity: O(1)
+func (t *AVLTree[T]) Height() int {
+	return avlHeight(t.root)
+}
+
+// Size returns the number of values in the tree.
+// Time complexity: O(1)
+func (t *AVLTree[T]) Size() int {
+	return t.size
+}
+
+// IsEmpty returns true if the tree is empty.
+// Time complexity: O(1)
+func (t *AVLTree[T]) IsEmpty() bool {
+	return t.size == 0
+}
+
+// insert adds value to the subtree rooted at node and returns the new, rebalanced root.
+func (t *AVLTree[T]) insert(node *avlNode[T], value T) (*avlNode[T], bool) {
+	if node == nil {
+		return &avlNode[T]{value: value, height: 1}, true
+	}
+
+	var inserted bool
+	switch {
+	case t.less(value, node.value):
+		node.left, inserted = t.insert(node.left, value)
+	case t.less(node.value, value):
+		node.right, inserted = t.insert(node.right, value)
+	default:
+		return node, false
+	}
+
+	if !inserted {
+		return node, false
+	}
+	return avlRebalance(node), true
+}
+
+// delete removes value from the subtree rooted at node and returns the new, rebalanced root.
+func (t *AVLTree[T]) delete(node *avlNode[T], value T) (*avlNode[T], bool) {
+	if node == nil {
+		return nil, false
+	}
+
+	var deleted bool
+	switch {
+	case t.less(value, node.value):
+		node.left, deleted = t.delete(node.left, value)
+	case t.less(node.value, value):
+		node.right, deleted = t.delete(node.right, value)
+	default:
+		deleted = true
+		if node.left == nil {
+			return node.right, true
+		}
+		if node.right == nil {
+			return node.left, true
+		}
+
+		// Two children: replace with the in-order successor, then delete it from the right
+		successor := node.right
+		for successor.left != nil {
+			successor = successor.left
+		}
+		node.value = successor.value
+		node.right, _ = t.delete(node.right, successor.value)
+	}
+
+	if !deleted {
+		return node, false
+	}
+	return avlRebalance(node), true
+}
+
+// avlHeight returns the height of a subtree, treating nil as 0.
+func avlHeight[T any](node *avlNode[T]) int {
+	if node == nil {
+		return 0
+	}
+	return node.height
+}
+
+// avlUpdateHeight recomputes a node's height from its children.
+func avlUpdateHeight[T any](node *avlNode[T]) {
+	node.height = 1 + max(avlHeight(node.left), avlHeight(node.right))
+}
+
+// avlRotateRight lifts node's left child into its place and returns the new subtree root.
+func avlRotateRight[T any](node *avlNode[T]) *avlNode[T] {
+	pivot := node.left
+	node.left = pivot.right
+	pivot.right = node
+
+	avlUpdateHeight(node)
+	avlUpdateHeight(pivot)
+	return pivot
+}
+
+// avlRotateLeft lifts node's right child into its place and returns the new subtree root.
+func avlRotateLeft[T any](node *avlNode[T]) *avlNode[T] {
+	pivot := node.right
+	node.right = pivot.left
+	pivot.left = node
+
+	avlUpdateHeight(node)
+	avlUpdateHeight(pivot)
+	return pivot
+}
+
+// avlRebalance restores the AVL property at node after one of its subtrees changed height.
+func avlRebalance[T any](node *avlNode[T]) *avlNode[T] {
+	avlUpdateHeight(node)
+	balance := avlHeight(node.left) - avlHeight(node.right)
+
+	switch {
+	case balance > 1:
+		// Left-right case: rotate the left child first
+		if avlHeight(node.left.left) < avlHeight(node.left.right) {
+			node.left = avlRotateLeft(node.left)
+		}
+		return avlRotateRight(node)
+	case balance < -1:
+		// Right-left case: rotate the right child first
+		if avlHeight(node.right.right) < avlHeight(node.right.left) {
+			node.right = avlRotateRight(node.right)
+		}
+		return avlRotateLeft(node)
+	default:
+		return node
+	}
+}
@@ -0,0 +1,257 @@
+package collections
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func intLess(a, b int) bool { return a < b }
+
+// checkAVL verifies ordering, stored heights and balance factors for every node.
+func checkAVL(t *testing.T, node *avlNode[int], lo, hi int) int {
+	t.Helper()
+
+	if node == nil {
+		return 0
+	}
+
+	if node.value <= lo || node.value >= hi {
+		t.Fatalf("value %d violates BST ordering bounds (%d, %d)", node.value, lo, hi)
+	}
+
+	left := checkAVL(t, node.left, lo, node.value)
+	right := checkAVL(t, node.right, node.value, hi)
+
+	if diff := left - right; diff > 1 || diff < -1 {
+		t.Fatalf("node %d is unbalanced: left height %d, right height %d", node.value, left, right)
+	}
+
+	h := 1 + max(left, right)
+	if node.height != h {
+		t.Fatalf("node %d stores height %d, actual %d", node.value, node.height, h)
+	}
+
+	return h
+}
+
+func TestNewAVLTree(t *testing.T) {
+	tree := NewAVLTree(intLess)
+
+	if tree.Size() != 0 || !tree.IsEmpty() {
+		t.Error("expected empty tree")
+	}
+
+	if tree.Height() != 0 {
+		t.Errorf("expected height 0, got %d", tree.Height())
+	}
+
+	if tree.Contains(1) {
+		t.Error("expected empty tree not to contain 1")
+	}
+
+	if tree.Delete(1) {
+		t.Error("expected delete on empty tree to fail")
+	}
+}
+
+func TestAVLTreeInsertSorted(t *testing.T) {
+	const n = 1000
+	tree := NewAVLTree(intLess)
+
+	for i := 1; i <= n; i++ {
+		if !tree.Insert(i) {
+			t.Fatalf("expected insert of %d to succeed", i)
+		}
+	}
+
+	if tree.Size() != n {
+		t.Errorf("expected size %d, got %d", n, tree.Size())
+	}
+
+	// AVL height is bounded by about 1.44 * log2(n + 2)
+	bound := int(1.44 * math.Log2(n+2))
+	if tree.Height() > bound {
+		t.Errorf("expected height <= %d for %d sorted inserts, got %d", bound, n, tree.Height())
+	}
+
+	checkAVL(t, tree.root, math.MinInt, math.MaxInt)
+
+	inOrder := tree.InOrder()
+	for i, v := range inOrder {
+		if v != i+1 {
+			t.Fatalf("expected in-order value %d at index %d, got %d", i+1, i, v)
+		}
+	}
+}
+
+func TestAVLTreeDuplicates(t *testing.T) {
+	tree := NewAVLTree(intLess)
+	tree.Insert(5)
+
+	if tree.Insert(5) {
+		t.Error("expected duplicate insert to return false")
+	}
+
+	if tree.Size() != 1 {
+		t.Errorf("expected size 1, got %d", tree.Size())
+	}
+}
+
+func TestAVLTreeDelete(t *testing.T) {
+	tests := []struct {
+		name     string
+		remove   []int
+		expected []int
+	}{
+		{"leaf", []int{1}, []int{2, 3, 4, 5, 6, 7}},
+		{"node with two children", []int{4}, []int{1, 2, 3, 5, 6, 7}},
+		{"root repeatedly", []int{4, 5, 6}, []int{1, 2, 3, 7}},
+		{"missing value", []int{10}, []int{1, 2, 3, 4, 5, 6, 7}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree := NewAVLTree(intLess)
+			for i := 1; i <= 7; i++ {
+				tree.Insert(i)
+			}
+
+			for _, v := range tt.remove {
+				tree.Delete(v)
+			}
+
+			if !reflect.DeepEqual(tree.InOrder(), tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, tree.InOrder())
+			}
+
+			if tree.Size() != len(tt.expected) {
+				t.Errorf("expected size %d, got %d", len(tt.expected), tree.Size())
+			}
+
+			checkAVL(t, tree.root, math.MinInt, math.MaxInt)
+		})
+	}
+}
+
+func TestAVLTreeDeleteRebalancing(t *testing.T) {
+	const n = 1000
+	tree := NewAVLTree(intLess)
+	for i := 1; i <= n; i++ {
+		tree.Insert(i)
+	}
+
+	// Remove the entire lower half, which would leave a plain BST lopsided
+	for i := 1; i <= n/2; i++ {
+		if !tree.Delete(i) {
+			t.Fatalf("expected delete of %d to succeed", i)
+		}
+		if i%50 == 0 {
+			checkAVL(t, tree.root, math.MinInt, math.MaxInt)
+		}
+	}
+
+	if tree.Contains(n/2) || !tree.Contains(n/2+1) {
+		t.Error("unexpected membership after deletions")
+	}
+
+	bound := int(1.44 * math.Log2(n/2+2))
+	if tree.Height() > bound {
+		t.Errorf("expected height <= %d after deletions, got %d", bound, tree.Height())
+	}
+}
+
+func TestAVLTreeContains(t *testing.T) {
+	tree := NewAVLTree(func(a, b string) bool { return a < b })
+	for _, v := range []string{"m", "c", "x", "a", "e"} {
+		tree.Insert(v)
+	}
+
+	if !tree.Contains("e") {
+		t.Error("expected tree to contain e")
+	}
+
+	if tree.Contains("z") {
+		t.Error("expected tree not to contain z")
+	}
+}
+
+func TestAVLTreeMinMax(t *testing.T) {
+	tree := NewAVLTree(intLess)
+
+	if _, ok := tree.Min(); ok {
+		t.Error("expected Min to fail on empty tree")
+	}
+	if _, ok := tree.Max(); ok {
+		t.Error("expected Max to fail on empty tree")
+	}
+
+	for _, v := range []int{50, 20, 80, 10, 30, 90, 5} {
+		tree.Insert(v)
+	}
+
+	steps := []struct {
+		name     string
+		action   func()
+		min, max int
+	}{
+		{"after inserts", func() {}, 5, 90},
+		{"delete min", func() { tree.Delete(5) }, 10, 90},
+		{"delete max", func() { tree.Delete(90) }, 10, 80},
+		{"insert new extremes", func() { tree.Insert(1); tree.Insert(100) }, 1, 100},
+	}
+
+	for _, step := range steps {
+		step.action()
+
+		if got, ok := tree.Min(); !ok || got != step.min {
+			t.Errorf("%s: expected min %d, got %d (ok=%v)", step.name, step.min, got, ok)
+		}
+		if got, ok := tree.Max(); !ok || got != step.max {
+			t.Errorf("%s: expected max %d, got %d (ok=%v)", step.name, step.max, got, ok)
+		}
+	}
+}
+
+func TestAVLTreeSuccessorPredecessor(t *testing.T) {
+	tree := NewAVLTree(intLess)
+	for _, v := range []int{50, 20, 80, 10, 30, 70, 90} {
+		tree.Insert(v)
+	}
+
+	tests := []struct {
+		name           string
+		value          int
+		successor      int
+		hasSuccessor   bool
+		predecessor    int
+		hasPredecessor bool
+	}{
+		{"interior present", 30, 50, true, 20, true},
+		{"root", 50, 70, true, 30, true},
+		{"interior absent", 55, 70, true, 50, true},
+		{"min has no predecessor", 10, 20, true, 0, false},
+		{"max has no successor", 90, 0, false, 80, true},
+		{"below all", 1, 10, true, 0, false},
+		{"above all", 100, 0, false, 90, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, ok := tree.Successor(tt.value); ok != tt.hasSuccessor || got != tt.successor {
+				t.Errorf("expected successor %d (ok=%v), got %d (ok=%v)", tt.successor, tt.hasSuccessor, got, ok)
+			}
+			if got, ok := tree.Predecessor(tt.value); ok != tt.hasPredecessor || got != tt.predecessor {
+				t.Errorf("expected predecessor %d (ok=%v), got %d (ok=%v)", tt.predecessor, tt.hasPredecessor, got, ok)
+			}
+		})
+	}
+
+	empty := NewAVLTree(intLess)
+	if _, ok := empty.Successor(1); ok {
+		t.Error("expected no successor in empty tree")
+	}
+	if _, ok := empty.Predecessor(1); ok {
+		t.Error("expected no predecessor in empty tree")
+	}
+}
@@ -0,0 +1,104 @@
+package collections
+
+import "iter"
+
+// BoundedList represents a linked list holding at most a fixed number of elements.
+// Appending to a full list removes the head, the oldest element, so the list behaves
+// as a rolling log of the most recent maxSize appends.
+type BoundedList[T any] struct {
+	list    *LinkedList[T]
+	maxSize int
+}
+
+// NewBoundedList creates and returns a new empty list holding at most maxSize elements.
+// A maxSize below 1 is treated as 1.
+func NewBoundedList[T any](maxSize int) *BoundedList[T] {
+	if maxSize < 1 {
+		maxSize = 1
+	}
+	return &BoundedList[T]{
+		list:    NewLinkedList[T](),
+		maxSize: maxSize,
+	}
+}
+
+// Append adds an element to the end of the list.
+// If the list was full, the head is removed and returned with true.
+// Time complexity: O(1)
+func (bl *BoundedList[T]) Append(value T) (T, bool) {
+	var evicted T
+	full := bl.list.size == bl.maxSize
+	if full {
+		evicted = bl.list.sentinel.Next.Value
+		bl.list.unlinkAfter(&bl.list.sentinel)
+	}
+
+	bl.list.Append(value)
+	return evicted, full
+}
+
+// Head returns the oldest element.
+// Returns an error if the list is empty.
+// Time complexity: O(1)
+func (bl *BoundedList[T]) Head() (T, error) {
+	return bl.list.Head()
+}
+
+// Tail returns the most recently appended element.
+// Returns an error if the list is empty.
+// Time complexity: O(1)
+func (bl *BoundedList[T]) Tail() (T, error) {
+	return bl.list.Tail()
+}
+
+// Get returns the element at the specified index (0 is the oldest).
+// Time complexity: O(n)
+func (bl *BoundedList[T]) Get(index int) (T, error) {
+	return bl.list.Get(index)
+}
+
+// Size returns the number of elements in the list.
+// Time complexity: O(1)
+func (bl *BoundedList[T]) Size() int {
+	return bl.list.size
+}
+
+// MaxSize returns the maximum number of elements the list holds.
+func (bl *BoundedList[T]) MaxSize() int {
+	return bl.maxSize
+}
+
+// IsEmpty returns true if the list is empty.
+// Time complexity: O(1)
+func (bl *BoundedList[T]) IsEmpty() bool {
+	return bl.list.size == 0
+}
+
+// IsFull returns true if the next Append will evict the head.
+// Time complexity: O(1)
+func (bl *BoundedList[T]) IsFull() bool {
+	return bl.list.size == bl.maxSize
+}
+
+// Clear removes all elements from the list.
+// Time complexity: O(1)
+func (bl *BoundedList[T]) Clear() {
+	bl.list.Clear()
+}
+
+// ToSlice returns the elements as a slice, oldest first.
+// Time complexity: O(n)
+func (bl *BoundedList[T]) ToSlice() []T {
+	return bl.list.ToSlice()
+}
+
+// All returns an iterator over the elements, oldest first.
+// The list must not be modified during iteration.
+func (bl *BoundedList[T]) All() iter.Seq[T] {
+	return bl.list.All()
+}
+
+// String returns a string representation of the list.
+func (bl *BoundedList[T]) String() string {
+	return bl.list.String()
+}
@@ -0,0 +1,74 @@
+package collections
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewBoundedList(t *testing.T) {
+	bl := NewBoundedList[int](3)
+
+	if bl.Size() != 0 || !bl.IsEmpty() || bl.IsFull() {
+		t.Error("expected empty, non-full list")
+	}
+
+	if bl.MaxSize() != 3 {
+		t.Errorf("expected max size 3, got %d", bl.MaxSize())
+	}
+
+	if NewBoundedList[int](-1).MaxSize() != 1 {
+		t.Error("expected non-positive max size to be raised to 1")
+	}
+}
+
+func TestBoundedListAppend(t *testing.T) {
+	bl := NewBoundedList[int](3)
+
+	for i := 1; i <= 3; i++ {
+		if _, evicted := bl.Append(i); evicted {
+			t.Errorf("expected no eviction while filling, appended %d", i)
+		}
+	}
+
+	if !bl.IsFull() {
+		t.Error("expected list to be full")
+	}
+
+	for i := 4; i <= 7; i++ {
+		dropped, evicted := bl.Append(i)
+		if !evicted || dropped != i-3 {
+			t.Errorf("append %d: expected eviction of %d, got %d (evicted=%v)", i, i-3, dropped, evicted)
+		}
+
+		if bl.Size() != 3 {
+			t.Errorf("append %d: expected size 3, got %d", i, bl.Size())
+		}
+	}
+
+	if !reflect.DeepEqual(bl.ToSlice(), []int{5, 6, 7}) {
+		t.Errorf("expected [5 6 7], got %v", bl.ToSlice())
+	}
+
+	head, _ := bl.Head()
+	tail, _ := bl.Tail()
+	if head != 5 || tail != 7 {
+		t.Errorf("expected head 5 and tail 7, got %d and %d", head, tail)
+	}
+}
+
+func TestBoundedListSingle(t *testing.T) {
+	bl := NewBoundedList[string](1)
+	bl.Append("a")
+
+	dropped, evicted := bl.Append("b")
+	if !evicted || dropped != "a" {
+		t.Errorf("expected eviction of a, got %q (evicted=%v)", dropped, evicted)
+	}
+
+	// Head and tail are the same single node after eviction
+	head, _ := bl.Head()
+	tail, _ := bl.Tail()
+	if head != "b" || tail != "b" || bl.Size() != 1 {
+		t.Errorf("expected single element b, got %v", bl.ToSlice())
+	}
+}
@@ -0,0 +1,54 @@
+package collections
+
+import "io"
+
+// ByteDeque is a Deque of bytes that works as a simple byte buffer: bytes are written
+// to the back and read from the front. Like ByteQueue it wraps the generic type, and
+// every Deque method is still available through the embedded deque.
+type ByteDeque struct {
+	*Deque[byte]
+}
+
+// NewByteDeque creates and returns a new empty byte deque.
+// An existing deque can be wrapped directly as &ByteDeque{Deque: dq}.
+func NewByteDeque() *ByteDeque {
+	return &ByteDeque{Deque: NewDeque[byte]()}
+}
+
+// Read removes up to len(p) bytes from the front of the deque into p, implementing io.Reader.
+// It returns the number of bytes read, or 0 and io.EOF if the deque is empty.
+// Time complexity: O(n) where n is the number of bytes read
+func (bd *ByteDeque) Read(p []byte) (int, error) {
+	dq := bd.Deque
+	if len(p) == 0 {
+		return 0, nil
+	}
+	if dq.size == 0 {
+		return 0, io.EOF
+	}
+
+	total := 0
+	for total < len(p) && dq.size > 0 {
+		// Copy the contiguous run up to the end of the buffer, then the wrapped part
+		end := min(dq.front+dq.size, len(dq.items))
+		n := copy(p[total:], dq.items[dq.front:end])
+
+		dq.front = (dq.front + n) % len(dq.items)
+		dq.size -= n
+		total += n
+	}
+
+	if dq.size == 0 {
+		dq.Clear()
+	}
+
+	return total, nil
+}
+
+// Write appends the bytes of p to the back of the deque, implementing io.Writer.
+// It always writes all of p and returns len(p) and a nil error.
+// Time complexity: O(n) amortized where n is len(p)
+func (bd *ByteDeque) Write(p []byte) (int, error) {
+	bd.PushBackN(p...)
+	return len(p), nil
+}
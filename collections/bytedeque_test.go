@@ -0,0 +1,92 @@
+package collections
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"testing"
+)
+
+func TestByteDequeWriteRead(t *testing.T) {
+	bd := NewByteDeque()
+
+	var _ io.ReadWriter = bd
+
+	n, err := bd.Write([]byte("hello, "))
+	if err != nil || n != 7 {
+		t.Fatalf("expected 7 bytes written, got %d, error=%v", n, err)
+	}
+	bd.Write([]byte("world"))
+
+	data, err := io.ReadAll(bd)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if string(data) != "hello, world" {
+		t.Errorf("expected %q, got %q", "hello, world", data)
+	}
+
+	if !bd.IsEmpty() {
+		t.Errorf("expected empty deque after reading everything, got size %d", bd.Size())
+	}
+}
+
+func TestByteDequePartialRead(t *testing.T) {
+	bd := NewByteDeque()
+	bd.Write([]byte("abcdef"))
+
+	buf := make([]byte, 4)
+	n, err := bd.Read(buf)
+	if err != nil || n != 4 || string(buf[:n]) != "abcd" {
+		t.Errorf("expected 4 bytes \"abcd\", got %d bytes %q, error=%v", n, buf[:n], err)
+	}
+
+	n, err = bd.Read(buf)
+	if err != nil || n != 2 || string(buf[:n]) != "ef" {
+		t.Errorf("expected 2 bytes \"ef\", got %d bytes %q, error=%v", n, buf[:n], err)
+	}
+}
+
+func TestByteDequeReadWrapped(t *testing.T) {
+	// Force the contents to wrap around the end of the circular buffer
+	dq := NewDequeWithCapacity[byte](4)
+	dq.PushBackN('x', 'x', 'a', 'b')
+	dq.PopFront()
+	dq.PopFront()
+	dq.PushBackN('c', 'd')
+
+	bd := &ByteDeque{Deque: dq}
+	buf := make([]byte, 8)
+	n, err := bd.Read(buf)
+	if err != nil || n != 4 || string(buf[:n]) != "abcd" {
+		t.Errorf("expected 4 bytes \"abcd\", got %d bytes %q, error=%v", n, buf[:n], err)
+	}
+}
+
+func TestByteDequeEOF(t *testing.T) {
+	bd := NewByteDeque()
+	buf := make([]byte, 4)
+
+	if n, err := bd.Read(buf); n != 0 || err != io.EOF {
+		t.Errorf("expected 0 and io.EOF on empty deque, got %d, error=%v", n, err)
+	}
+
+	bd.Write([]byte("ab"))
+	if n, err := bd.Read(nil); n != 0 || err != nil {
+		t.Errorf("expected 0 and nil error for empty buffer, got %d, error=%v", n, err)
+	}
+
+	bd.Read(buf)
+	if n, err := bd.Read(buf); n != 0 || err != io.EOF {
+		t.Errorf("expected 0 and io.EOF once drained, got %d, error=%v", n, err)
+	}
+
+	// The deque stays usable after reaching EOF
+	bd.Write([]byte("cd"))
+	var out bytes.Buffer
+	out.ReadFrom(bd)
+	if !reflect.DeepEqual(out.Bytes(), []byte("cd")) {
+		t.Errorf("expected %q, got %q", "cd", out.Bytes())
+	}
+}
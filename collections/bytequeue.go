@@ -0,0 +1,69 @@
+package collections
+
+import "io"
+
+// ByteQueue is a Queue of bytes with byte-specific I/O methods.
+// Go methods cannot be specialised to one type argument, so these live on a small
+// wrapper; every Queue method is still available through the embedded queue.
+type ByteQueue struct {
+	*Queue[byte]
+}
+
+// NewByteQueue creates and returns a new empty byte queue.
+// An existing queue can be wrapped directly as &ByteQueue{Queue: q}.
+func NewByteQueue() *ByteQueue {
+	return &ByteQueue{Queue: NewQueue[byte]()}
+}
+
+// FromBytesQueue creates a new byte queue holding a copy of b.
+// The first byte becomes the front of the queue.
+// Time complexity: O(n)
+func FromBytesQueue(b []byte) *ByteQueue {
+	return &ByteQueue{Queue: FromSliceQueue(b)}
+}
+
+// Bytes returns a copy of the queue's contents as a byte slice, from front to rear.
+// The queue is unchanged.
+// Time complexity: O(n)
+func (bq *ByteQueue) Bytes() []byte {
+	return bq.ToSlice()
+}
+
+// WriteTo drains the queue into w in FIFO order, implementing io.WriterTo.
+// It returns the number of bytes written. Bytes accepted by w are removed from the
+// queue even when an error is returned, so the queue holds exactly the unwritten rest.
+// A short write without an error is reported as io.ErrShortWrite.
+// Drained bytes are counted in stats as dequeued, but since they are removed in bulk
+// rather than through Dequeue, they are not reported to an OnChange callback.
+// Time complexity: O(n)
+func (bq *ByteQueue) WriteTo(w io.Writer) (int64, error) {
+	q := bq.Queue
+	var total int64
+
+	for q.size > 0 {
+		// Write the contiguous run up to the end of the buffer, then the wrapped part
+		end := min(q.front+q.size, len(q.items))
+		chunk := q.items[q.front:end]
+
+		n, err := w.Write(chunk)
+		n = max(0, min(n, len(chunk)))
+
+		q.front = (q.front + n) % len(q.items)
+		q.size -= n
+		total += int64(n)
+
+		if q.stats != nil {
+			q.stats.TotalDequeued += n
+		}
+
+		if err != nil {
+			return total, err
+		}
+		if n < len(chunk) {
+			return total, io.ErrShortWrite
+		}
+	}
+
+	q.Clear()
+	return total, nil
+}
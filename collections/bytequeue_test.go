@@ -0,0 +1,102 @@
+package collections
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+)
+
+// limitedWriter accepts up to limit bytes in total, then fails.
+type limitedWriter struct {
+	buf   bytes.Buffer
+	limit int
+}
+
+func (w *limitedWriter) Write(p []byte) (int, error) {
+	room := w.limit - w.buf.Len()
+	if len(p) <= room {
+		return w.buf.Write(p)
+	}
+	w.buf.Write(p[:room])
+	return room, errors.New("writer full")
+}
+
+func TestByteQueueWriteTo(t *testing.T) {
+	bq := NewByteQueue()
+	bq.MultiEnqueue([]byte("hello, world")...)
+
+	var _ io.WriterTo = bq
+
+	var buf bytes.Buffer
+	n, err := bq.WriteTo(&buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if n != 12 || buf.String() != "hello, world" {
+		t.Errorf("expected 12 bytes \"hello, world\", got %d bytes %q", n, buf.String())
+	}
+
+	if !bq.IsEmpty() {
+		t.Errorf("expected drained queue, got size %d", bq.Size())
+	}
+}
+
+func TestByteQueueWriteToWrapped(t *testing.T) {
+	// Force the contents to wrap around the end of the circular buffer
+	q := NewQueueWithCapacity[byte](4)
+	q.MultiEnqueue('x', 'x', 'a', 'b')
+	q.Dequeue()
+	q.Dequeue()
+	q.MultiEnqueue('c', 'd')
+
+	var buf bytes.Buffer
+	n, err := (&ByteQueue{Queue: q}).WriteTo(&buf)
+	if err != nil || n != 4 || buf.String() != "abcd" {
+		t.Errorf("expected 4 bytes \"abcd\", got %d bytes %q, error=%v", n, buf.String(), err)
+	}
+}
+
+func TestByteQueueWriteToError(t *testing.T) {
+	bq := NewByteQueue()
+	bq.MultiEnqueue([]byte("abcdef")...)
+
+	w := &limitedWriter{limit: 4}
+	n, err := bq.WriteTo(w)
+	if err == nil {
+		t.Fatal("expected error from writer")
+	}
+
+	if n != 4 || w.buf.String() != "abcd" {
+		t.Errorf("expected 4 bytes \"abcd\" written, got %d bytes %q", n, w.buf.String())
+	}
+
+	// Unwritten bytes stay queued
+	if string(bq.ToSlice()) != "ef" {
+		t.Errorf("expected remaining \"ef\", got %q", bq.ToSlice())
+	}
+}
+
+func TestByteQueueBytes(t *testing.T) {
+	input := []byte("queue")
+	bq := FromBytesQueue(input)
+
+	// Rotate a byte through so the contents wrap in the buffer
+	b, _ := bq.Dequeue()
+	bq.Enqueue(b)
+
+	if expected := []byte("ueueq"); !bytes.Equal(bq.Bytes(), expected) {
+		t.Errorf("expected %q, got %q", expected, bq.Bytes())
+	}
+
+	input[1] = 'X'
+	if bq.Bytes()[0] != 'u' {
+		t.Errorf("expected queue unaffected by changes to the input, got %q", bq.Bytes())
+	}
+
+	round := FromBytesQueue(bq.Bytes())
+	if !bytes.Equal(round.Bytes(), bq.Bytes()) || bq.Size() != 5 {
+		t.Errorf("expected round trip to preserve %q, got %q", bq.Bytes(), round.Bytes())
+	}
+}
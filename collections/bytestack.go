@@ -0,0 +1,28 @@
+package collections
+
+// ByteStack is a Stack of bytes with byte-specific conversions.
+// Like ByteQueue it wraps the generic type, and every Stack method is still available
+// through the embedded stack.
+type ByteStack struct {
+	*Stack[byte]
+}
+
+// NewByteStack creates and returns a new empty byte stack.
+// An existing stack can be wrapped directly as &ByteStack{Stack: s}.
+func NewByteStack() *ByteStack {
+	return &ByteStack{Stack: NewStack[byte]()}
+}
+
+// FromBytesStack creates a new byte stack holding a copy of b.
+// The first byte becomes the bottom of the stack, so the last byte is popped first.
+// Time complexity: O(n)
+func FromBytesStack(b []byte) *ByteStack {
+	return &ByteStack{Stack: FromSliceStack(b)}
+}
+
+// Bytes returns a copy of the stack's contents as a byte slice, from bottom to top.
+// The stack is unchanged.
+// Time complexity: O(n)
+func (bs *ByteStack) Bytes() []byte {
+	return bs.ToSlice()
+}
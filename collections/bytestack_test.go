@@ -0,0 +1,34 @@
+package collections
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestByteStackBytes(t *testing.T) {
+	input := []byte("stack")
+	bs := FromBytesStack(input)
+
+	if !bytes.Equal(bs.Bytes(), input) {
+		t.Errorf("expected %q, got %q", input, bs.Bytes())
+	}
+
+	// The stack holds its own copy
+	input[0] = 'X'
+	if top, _ := bs.Peek(); top != 'k' || bs.Bytes()[0] != 's' {
+		t.Errorf("expected stack unaffected by changes to the input, got %q", bs.Bytes())
+	}
+
+	var popped []byte
+	for !bs.IsEmpty() {
+		b, _ := bs.Pop()
+		popped = append(popped, b)
+	}
+	if string(popped) != "kcats" {
+		t.Errorf("expected pop order %q, got %q", "kcats", popped)
+	}
+
+	if empty := NewByteStack().Bytes(); len(empty) != 0 {
+		t.Errorf("expected no bytes, got %q", empty)
+	}
+}
@@ -0,0 +1,96 @@
+package collections
+
+import (
+	"iter"
+	"sync"
+)
+
+// ConcurrentQueue is a Queue that is safe for concurrent use by multiple goroutines.
+// Every operation takes a lock on the underlying queue. Iteration works on a snapshot
+// taken under a read lock, so the lock is never held while caller code runs.
+type ConcurrentQueue[T any] struct {
+	mu    sync.RWMutex
+	queue *Queue[T]
+}
+
+// NewConcurrentQueue creates and returns a new empty concurrent queue.
+func NewConcurrentQueue[T any]() *ConcurrentQueue[T] {
+	return &ConcurrentQueue[T]{
+		queue: NewQueue[T](),
+	}
+}
+
+// Enqueue adds an element to the rear of the queue.
+// Time complexity: O(1) amortized
+func (cq *ConcurrentQueue[T]) Enqueue(value T) {
+	cq.mu.Lock()
+	defer cq.mu.Unlock()
+	cq.queue.Enqueue(value)
+}
+
+// Dequeue removes and returns the front element.
+// Returns an error wrapping ErrEmpty if the queue is empty.
+// Time complexity: O(1) amortized
+func (cq *ConcurrentQueue[T]) Dequeue() (T, error) {
+	cq.mu.Lock()
+	defer cq.mu.Unlock()
+	return cq.queue.Dequeue()
+}
+
+// Poll removes and returns the front element, reporting false instead of an error
+// if the queue is empty.
+// Time complexity: O(1) amortized
+func (cq *ConcurrentQueue[T]) Poll() (T, bool) {
+	cq.mu.Lock()
+	defer cq.mu.Unlock()
+	return cq.queue.Poll()
+}
+
+// Front returns the front element without removing it.
+// Returns an error wrapping ErrEmpty if the queue is empty.
+// Time complexity: O(1)
+func (cq *ConcurrentQueue[T]) Front() (T, error) {
+	cq.mu.RLock()
+	defer cq.mu.RUnlock()
+	return cq.queue.Front()
+}
+
+// Size returns the number of elements in the queue.
+// Time complexity: O(1)
+func (cq *ConcurrentQueue[T]) Size() int {
+	cq.mu.RLock()
+	defer cq.mu.RUnlock()
+	return cq.queue.Size()
+}
+
+// IsEmpty checks if the queue is empty.
+// Time complexity: O(1)
+func (cq *ConcurrentQueue[T]) IsEmpty() bool {
+	cq.mu.RLock()
+	defer cq.mu.RUnlock()
+	return cq.queue.IsEmpty()
+}
+
+// ToSlice returns a snapshot of the elements from front to rear, copied under a read lock.
+// The snapshot may be stale as soon as it is returned if other goroutines modify the queue.
+// Time complexity: O(n)
+func (cq *ConcurrentQueue[T]) ToSlice() []T {
+	cq.mu.RLock()
+	defer cq.mu.RUnlock()
+	return cq.queue.ToSlice()
+}
+
+// All returns an iterator over a snapshot of the elements from front to rear.
+// The snapshot is taken under a read lock when iteration starts, and the lock is
+// released before any element is yielded, so the loop body may safely call back into
+// the queue. Elements added or removed during iteration are not reflected.
+// Time complexity: O(n)
+func (cq *ConcurrentQueue[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, value := range cq.ToSlice() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package collections
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestConcurrentQueueBasicOperations(t *testing.T) {
+	cq := NewConcurrentQueue[int]()
+
+	if !cq.IsEmpty() {
+		t.Error("expected new queue to be empty")
+	}
+
+	if _, err := cq.Dequeue(); !errors.Is(err, ErrEmpty) {
+		t.Errorf("expected ErrEmpty, got %v", err)
+	}
+
+	cq.Enqueue(1)
+	cq.Enqueue(2)
+	cq.Enqueue(3)
+
+	if front, err := cq.Front(); err != nil || front != 1 {
+		t.Errorf("expected front 1, got %d, error=%v", front, err)
+	}
+
+	if value, err := cq.Dequeue(); err != nil || value != 1 {
+		t.Errorf("expected 1, got %d, error=%v", value, err)
+	}
+
+	if value, ok := cq.Poll(); !ok || value != 2 {
+		t.Errorf("expected 2, got %d, ok=%v", value, ok)
+	}
+
+	if cq.Size() != 1 {
+		t.Errorf("expected size 1, got %d", cq.Size())
+	}
+}
+
+func TestConcurrentQueueSnapshotIteration(t *testing.T) {
+	cq := NewConcurrentQueue[int]()
+	for i := 1; i <= 3; i++ {
+		cq.Enqueue(i)
+	}
+
+	// Modifying the queue from the loop body must not deadlock or change the iteration
+	var result []int
+	for value := range cq.All() {
+		result = append(result, value)
+		cq.Enqueue(value * 10)
+	}
+
+	if expected := []int{1, 2, 3}; !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+
+	if expected := []int{1, 2, 3, 10, 20, 30}; !reflect.DeepEqual(cq.ToSlice(), expected) {
+		t.Errorf("expected %v, got %v", expected, cq.ToSlice())
+	}
+}
+
+// Run with -race to check iteration does not race with writers.
+func TestConcurrentQueueIterateWhileWriting(t *testing.T) {
+	cq := NewConcurrentQueue[int]()
+	done := make(chan struct{})
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; ; i++ {
+			select {
+			case <-done:
+				return
+			default:
+			}
+			cq.Enqueue(i)
+			if i%2 == 1 {
+				cq.Dequeue()
+			}
+		}
+	}()
+
+	for range 100 {
+		previous := -1
+		for value := range cq.All() {
+			if value <= previous {
+				t.Errorf("expected increasing snapshot, got %d after %d", value, previous)
+			}
+			previous = value
+		}
+		_ = cq.ToSlice()
+	}
+
+	close(done)
+	wg.Wait()
+}
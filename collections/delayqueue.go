@@ -0,0 +1,81 @@
+package collections
+
+import "time"
+
+// delayedItem pairs a DelayQueue element with the time it becomes available.
+type delayedItem[T any] struct {
+	value   T
+	readyAt time.Time
+	seq     uint64 // Insertion order, breaks ties between equal ready times
+}
+
+// DelayQueue represents a queue whose elements only become available once their
+// ready time has passed. Implemented on a PriorityQueue keyed by ready time, so the
+// element that becomes ready first is always at the top. Elements with the same
+// ready time are returned in the order they were added.
+type DelayQueue[T any] struct {
+	heap    *PriorityQueue[delayedItem[T]]
+	nextSeq uint64
+}
+
+// NewDelayQueue creates and returns a new empty delay queue.
+func NewDelayQueue[T any]() *DelayQueue[T] {
+	return &DelayQueue[T]{
+		heap: NewPriorityQueue(func(a, b delayedItem[T]) bool {
+			if a.readyAt.Equal(b.readyAt) {
+				return a.seq < b.seq
+			}
+			return a.readyAt.Before(b.readyAt)
+		}),
+	}
+}
+
+// Add inserts item, to become available at readyAt.
+// Time complexity: O(log n)
+func (dq *DelayQueue[T]) Add(item T, readyAt time.Time) {
+	dq.heap.Push(delayedItem[T]{value: item, readyAt: readyAt, seq: dq.nextSeq})
+	dq.nextSeq++
+}
+
+// PollReady removes and returns the earliest element whose ready time is at or before now.
+// Returns the zero value and false if no element is ready yet.
+// Time complexity: O(log n)
+func (dq *DelayQueue[T]) PollReady(now time.Time) (T, bool) {
+	top, err := dq.heap.Peek()
+	if err != nil || top.readyAt.After(now) {
+		var zero T
+		return zero, false
+	}
+
+	dq.heap.Pop()
+	return top.value, true
+}
+
+// Poll is PollReady using the current time.
+// Time complexity: O(log n)
+func (dq *DelayQueue[T]) Poll() (T, bool) {
+	return dq.PollReady(time.Now())
+}
+
+// NextReadyAt returns the ready time of the earliest element, ready or not.
+// Returns the zero time and false if the queue is empty.
+// Time complexity: O(1)
+func (dq *DelayQueue[T]) NextReadyAt() (time.Time, bool) {
+	top, err := dq.heap.Peek()
+	if err != nil {
+		return time.Time{}, false
+	}
+	return top.readyAt, true
+}
+
+// Size returns the number of elements in the queue, ready or not.
+// Time complexity: O(1)
+func (dq *DelayQueue[T]) Size() int {
+	return dq.heap.Size()
+}
+
+// IsEmpty returns true if the queue holds no elements.
+// Time complexity: O(1)
+func (dq *DelayQueue[T]) IsEmpty() bool {
+	return dq.heap.IsEmpty()
+}
@@ -0,0 +1,82 @@
+package collections
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestNewDelayQueue(t *testing.T) {
+	dq := NewDelayQueue[string]()
+
+	if dq.Size() != 0 || !dq.IsEmpty() {
+		t.Error("expected empty delay queue")
+	}
+
+	if _, ok := dq.Poll(); ok {
+		t.Error("expected nothing to poll from empty delay queue")
+	}
+
+	if _, ok := dq.NextReadyAt(); ok {
+		t.Error("expected no next ready time for empty delay queue")
+	}
+}
+
+func TestDelayQueuePollReady(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(seconds int) time.Time { return base.Add(time.Duration(seconds) * time.Second) }
+
+	dq := NewDelayQueue[string]()
+	dq.Add("c", at(30))
+	dq.Add("a", at(10))
+	dq.Add("d", at(30)) // Same ready time as c, added later
+	dq.Add("b", at(20))
+
+	if next, _ := dq.NextReadyAt(); !next.Equal(at(10)) {
+		t.Errorf("expected next ready time %v, got %v", at(10), next)
+	}
+
+	tests := []struct {
+		now      int
+		expected []string
+	}{
+		{5, nil},
+		{10, []string{"a"}},
+		{25, []string{"b"}},
+		{29, nil},
+		{60, []string{"c", "d"}},
+	}
+
+	for _, tt := range tests {
+		var got []string
+		for {
+			v, ok := dq.PollReady(at(tt.now))
+			if !ok {
+				break
+			}
+			got = append(got, v)
+		}
+
+		if !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("at %ds: expected %v, got %v", tt.now, tt.expected, got)
+		}
+	}
+
+	if !dq.IsEmpty() {
+		t.Errorf("expected empty queue, got size %d", dq.Size())
+	}
+}
+
+func TestDelayQueuePoll(t *testing.T) {
+	dq := NewDelayQueue[int]()
+	dq.Add(1, time.Now().Add(-time.Second))
+	dq.Add(2, time.Now().Add(time.Hour))
+
+	if v, ok := dq.Poll(); !ok || v != 1 {
+		t.Errorf("expected past item 1, got %d (ok=%v)", v, ok)
+	}
+
+	if _, ok := dq.Poll(); ok {
+		t.Error("expected future item not to be ready")
+	}
+}
@@ -2,6 +2,9 @@ package collections
 
 import (
 	"fmt"
+	"iter"
+	"slices"
+	"sort"
 	"strings"
 )
 
@@ -58,7 +61,7 @@ func FromSliceDeque[T any](slice []T) *Deque[T] {
 	dq := &Deque[T]{
 		items: make([]T, capacity),
 		front: 0,
-		rear:  len(slice),
+		rear:  len(slice) % capacity, // Wraps to 0 when the buffer is exactly full
 		size:  len(slice),
 	}
 
@@ -66,6 +69,20 @@ func FromSliceDeque[T any](slice []T) *Deque[T] {
 	return dq
 }
 
+// DequeOf creates a new deque holding values, equivalent to FromSliceDeque(values).
+// The first value becomes the front of the deque.
+func DequeOf[T any](values ...T) *Deque[T] {
+	return FromSliceDeque(values)
+}
+
+// FromSeqDeque creates a new deque holding every element yielded by seq, in order.
+// Time complexity: O(n)
+func FromSeqDeque[T any](seq iter.Seq[T]) *Deque[T] {
+	dq := NewDeque[T]()
+	dq.PushBackSeq(seq)
+	return dq
+}
+
 // PushFront adds an element to the front of the deque.
 // Time complexity: O(1) amortized
 func (dq *Deque[T]) PushFront(value T) {
@@ -90,6 +107,44 @@ func (dq *Deque[T]) PushBack(value T) {
 	dq.size++
 }
 
+// PushBackN adds multiple elements to the back of the deque.
+// Elements are added in order, so the last value ends up at the back.
+// The buffer is grown at most once for the whole batch.
+// Time complexity: O(n) where n is the number of elements
+func (dq *Deque[T]) PushBackN(values ...T) {
+	dq.reserve(len(values))
+
+	for _, value := range values {
+		dq.items[dq.rear] = value
+		dq.rear = (dq.rear + 1) % len(dq.items)
+	}
+	dq.size += len(values)
+}
+
+// PushFrontN adds multiple elements to the front of the deque.
+// Elements are pushed in order, so the last value ends up frontmost,
+// exactly as if PushFront were called for each value in turn.
+// The buffer is grown at most once for the whole batch.
+// Time complexity: O(n) where n is the number of elements
+func (dq *Deque[T]) PushFrontN(values ...T) {
+	dq.reserve(len(values))
+
+	for _, value := range values {
+		dq.front = (dq.front - 1 + len(dq.items)) % len(dq.items)
+		dq.items[dq.front] = value
+	}
+	dq.size += len(values)
+}
+
+// PushBackSeq adds every element yielded by seq to the back of the deque, in order.
+// The length of seq is unknown up front, so the buffer grows with the usual amortized resizing.
+// Time complexity: O(n) amortized where n is the number of elements yielded
+func (dq *Deque[T]) PushBackSeq(seq iter.Seq[T]) {
+	for value := range seq {
+		dq.PushBack(value)
+	}
+}
+
 // PopFront removes and returns the front element from the deque.
 // Returns an error if the deque is empty.
 // Time complexity: O(1)
@@ -97,7 +152,7 @@ func (dq *Deque[T]) PopFront() (T, error) {
 	var zero T
 
 	if dq.size == 0 {
-		return zero, fmt.Errorf("deque is empty")
+		return zero, fmt.Errorf("deque: %w", ErrEmpty)
 	}
 
 	value := dq.items[dq.front]
@@ -121,7 +176,7 @@ func (dq *Deque[T]) PopBack() (T, error) {
 	var zero T
 
 	if dq.size == 0 {
-		return zero, fmt.Errorf("deque is empty")
+		return zero, fmt.Errorf("deque: %w", ErrEmpty)
 	}
 
 	dq.rear = (dq.rear - 1 + len(dq.items)) % len(dq.items)
@@ -138,6 +193,76 @@ func (dq *Deque[T]) PopBack() (T, error) {
 	return value, nil
 }
 
+// TryPopFront removes and returns the front element from the deque.
+// Returns the zero value and false if the deque is empty, avoiding the
+// error allocation of PopFront in tight loops.
+// Time complexity: O(1)
+func (dq *Deque[T]) TryPopFront() (T, bool) {
+	if dq.size == 0 {
+		var zero T
+		return zero, false
+	}
+
+	value, _ := dq.PopFront()
+	return value, true
+}
+
+// TryPopBack removes and returns the back element from the deque.
+// Returns the zero value and false if the deque is empty, avoiding the
+// error allocation of PopBack in tight loops.
+// Time complexity: O(1)
+func (dq *Deque[T]) TryPopBack() (T, bool) {
+	if dq.size == 0 {
+		var zero T
+		return zero, false
+	}
+
+	value, _ := dq.PopBack()
+	return value, true
+}
+
+// PopFrontN removes n elements from the front of the deque.
+// Returns the elements in the order they were popped, front first.
+// Returns an error if there aren't enough elements.
+// Time complexity: O(n)
+func (dq *Deque[T]) PopFrontN(n int) ([]T, error) {
+	if n < 0 {
+		return nil, fmt.Errorf("%w: cannot pop negative number of elements: %d", ErrInvalidArgument, n)
+	}
+
+	if n > dq.size {
+		return nil, fmt.Errorf("%w: cannot pop %d elements from deque of size %d", ErrInsufficientElements, n, dq.size)
+	}
+
+	result := make([]T, n)
+	for i := 0; i < n; i++ {
+		result[i], _ = dq.PopFront()
+	}
+
+	return result, nil
+}
+
+// PopBackN removes n elements from the back of the deque.
+// Returns the elements in the order they were popped, back first.
+// Returns an error if there aren't enough elements.
+// Time complexity: O(n)
+func (dq *Deque[T]) PopBackN(n int) ([]T, error) {
+	if n < 0 {
+		return nil, fmt.Errorf("%w: cannot pop negative number of elements: %d", ErrInvalidArgument, n)
+	}
+
+	if n > dq.size {
+		return nil, fmt.Errorf("%w: cannot pop %d elements from deque of size %d", ErrInsufficientElements, n, dq.size)
+	}
+
+	result := make([]T, n)
+	for i := 0; i < n; i++ {
+		result[i], _ = dq.PopBack()
+	}
+
+	return result, nil
+}
+
 // Front returns the front element without removing it.
 // Returns an error if the deque is empty.
 // Time complexity: O(1)
@@ -145,7 +270,7 @@ func (dq *Deque[T]) Front() (T, error) {
 	var zero T
 
 	if dq.size == 0 {
-		return zero, fmt.Errorf("deque is empty")
+		return zero, fmt.Errorf("deque: %w", ErrEmpty)
 	}
 
 	return dq.items[dq.front], nil
@@ -158,13 +283,40 @@ func (dq *Deque[T]) Back() (T, error) {
 	var zero T
 
 	if dq.size == 0 {
-		return zero, fmt.Errorf("deque is empty")
+		return zero, fmt.Errorf("deque: %w", ErrEmpty)
 	}
 
 	backIndex := (dq.rear - 1 + len(dq.items)) % len(dq.items)
 	return dq.items[backIndex], nil
 }
 
+// FrontRef returns a pointer to the front element for in-place modification.
+// The pointer refers into the backing buffer and is invalidated by any operation
+// that pushes, pops or resizes the deque; do not retain it across such calls.
+// Returns an error if the deque is empty.
+// Time complexity: O(1)
+func (dq *Deque[T]) FrontRef() (*T, error) {
+	if dq.size == 0 {
+		return nil, fmt.Errorf("deque: %w", ErrEmpty)
+	}
+
+	return &dq.items[dq.front], nil
+}
+
+// BackRef returns a pointer to the back element for in-place modification.
+// The pointer refers into the backing buffer and is invalidated by any operation
+// that pushes, pops or resizes the deque; do not retain it across such calls.
+// Returns an error if the deque is empty.
+// Time complexity: O(1)
+func (dq *Deque[T]) BackRef() (*T, error) {
+	if dq.size == 0 {
+		return nil, fmt.Errorf("deque: %w", ErrEmpty)
+	}
+
+	backIndex := (dq.rear - 1 + len(dq.items)) % len(dq.items)
+	return &dq.items[backIndex], nil
+}
+
 // Size returns the number of elements in the deque.
 // Time complexity: O(1)
 func (dq *Deque[T]) Size() int {
@@ -191,6 +343,42 @@ func (dq *Deque[T]) Clear() {
 	dq.size = 0
 }
 
+// Truncate keeps only the front n elements, discarding the rest from the back.
+// Discarded slots are cleared for GC; the capacity is unchanged.
+// If n >= Size() this is a no-op, and n <= 0 empties the deque.
+// Time complexity: O(k) where k is the number of discarded elements
+func (dq *Deque[T]) Truncate(n int) {
+	if n >= dq.size {
+		return
+	}
+	n = max(n, 0)
+
+	var zero T
+	for dq.size > n {
+		dq.rear = (dq.rear - 1 + len(dq.items)) % len(dq.items)
+		dq.items[dq.rear] = zero
+		dq.size--
+	}
+}
+
+// TruncateFront keeps only the back n elements, discarding the rest from the front.
+// Discarded slots are cleared for GC; the capacity is unchanged.
+// If n >= Size() this is a no-op, and n <= 0 empties the deque.
+// Time complexity: O(k) where k is the number of discarded elements
+func (dq *Deque[T]) TruncateFront(n int) {
+	if n >= dq.size {
+		return
+	}
+	n = max(n, 0)
+
+	var zero T
+	for dq.size > n {
+		dq.items[dq.front] = zero
+		dq.front = (dq.front + 1) % len(dq.items)
+		dq.size--
+	}
+}
+
 // ToSlice returns a copy of the deque as a slice.
 // The first element is the front of the deque.
 // Time complexity: O(n)
@@ -205,6 +393,43 @@ func (dq *Deque[T]) ToSlice() []T {
 	return result
 }
 
+// ToReversedSlice returns a copy of the deque as a slice without modifying the deque.
+// The first element is the back of the deque, last element is the front.
+// Time complexity: O(n)
+func (dq *Deque[T]) ToReversedSlice() []T {
+	result := make([]T, dq.size)
+
+	for i := 0; i < dq.size; i++ {
+		index := (dq.front + dq.size - 1 - i) % len(dq.items)
+		result[i] = dq.items[index]
+	}
+
+	return result
+}
+
+// All returns an iterator over the elements from front to back.
+// The deque must not be modified during iteration.
+func (dq *Deque[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < dq.size; i++ {
+			if !yield(dq.items[(dq.front+i)%len(dq.items)]) {
+				return
+			}
+		}
+	}
+}
+
+// ForEachUntil calls f for each element from front to back, stopping as soon as f
+// returns false. It does not allocate.
+// Time complexity: O(n)
+func (dq *Deque[T]) ForEachUntil(f func(T) bool) {
+	for i := 0; i < dq.size; i++ {
+		if !f(dq.items[(dq.front+i)%len(dq.items)]) {
+			return
+		}
+	}
+}
+
 // Contains checks if the deque contains the specified value.
 // Time complexity: O(n)
 func (dq *Deque[T]) Contains(value T) bool {
@@ -217,9 +442,77 @@ func (dq *Deque[T]) Contains(value T) bool {
 	return false
 }
 
+// CountValue returns the number of elements equal to value, compared the same way as Contains.
+// Time complexity: O(n)
+func (dq *Deque[T]) CountValue(value T) int {
+	count := 0
+	for i := 0; i < dq.size; i++ {
+		if isEqual(dq.items[(dq.front+i)%len(dq.items)], value) {
+			count++
+		}
+	}
+	return count
+}
+
+// FindIndex returns the front-relative index of the first element for which pred
+// returns true, or -1 if no element matches.
+// Time complexity: O(n)
+func (dq *Deque[T]) FindIndex(pred func(T) bool) int {
+	for i := 0; i < dq.size; i++ {
+		index := (dq.front + i) % len(dq.items)
+		if pred(dq.items[index]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// FindLastIndex returns the front-relative index of the last element for which pred
+// returns true, or -1 if no element matches. The search starts from the back.
+// Time complexity: O(n)
+func (dq *Deque[T]) FindLastIndex(pred func(T) bool) int {
+	for i := dq.size - 1; i >= 0; i-- {
+		index := (dq.front + i) % len(dq.items)
+		if pred(dq.items[index]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// MapInPlace replaces every element with f applied to it, front to back,
+// reusing the existing buffer.
+// Time complexity: O(n)
+func (dq *Deque[T]) MapInPlace(f func(T) T) {
+	for i := 0; i < dq.size; i++ {
+		index := (dq.front + i) % len(dq.items)
+		dq.items[index] = f(dq.items[index])
+	}
+}
+
 // String returns a string representation of the deque.
 // Shows elements from front to back.
 func (dq *Deque[T]) String() string {
+	return dq.format("%v")
+}
+
+// GoString returns a string representation of the deque with Go-syntax quoted elements,
+// so string elements containing separators remain unambiguous. Implements fmt.GoStringer.
+func (dq *Deque[T]) GoString() string {
+	return dq.format("%#v")
+}
+
+// Fingerprint returns a string key identifying the deque's contents, for use as a
+// map or Memo key. The fingerprint is the GoString form, so two deques with the same
+// elements in the same order (front to back) have equal fingerprints regardless of capacity
+// or where their circular buffers start. Elements should have a deterministic %#v form.
+// Time complexity: O(n)
+func (dq *Deque[T]) Fingerprint() string {
+	return dq.GoString()
+}
+
+// format renders the deque from front to back using verb for each element.
+func (dq *Deque[T]) format(verb string) string {
 	if dq.size == 0 {
 		return "Deque[]"
 	}
@@ -232,14 +525,16 @@ func (dq *Deque[T]) String() string {
 			sb.WriteString(", ")
 		}
 		index := (dq.front + i) % len(dq.items)
-		sb.WriteString(fmt.Sprintf("%v", dq.items[index]))
+		sb.WriteString(fmt.Sprintf(verb, dq.items[index]))
 	}
 
 	sb.WriteString("] (front -> back)")
 	return sb.String()
 }
 
-// Clone creates a deep copy of the deque.
+// Clone creates a copy of the deque with its own buffer.
+// The copy is shallow: elements are copied by assignment, so pointer elements share
+// their pointees with the original. Use CloneWith to copy elements deeply.
 // Time complexity: O(n)
 func (dq *Deque[T]) Clone() *Deque[T] {
 	clone := NewDequeWithCapacity[T](len(dq.items))
@@ -252,36 +547,140 @@ func (dq *Deque[T]) Clone() *Deque[T] {
 	return clone
 }
 
+// CloneWith creates a copy of the deque whose elements are produced by copyElem,
+// preserving front-to-back order. Pass a function that duplicates each element to
+// deep-copy pointer or reference elements.
+// Time complexity: O(n) calls to copyElem
+func (dq *Deque[T]) CloneWith(copyElem func(T) T) *Deque[T] {
+	clone := NewDequeWithCapacity[T](len(dq.items))
+
+	for i := 0; i < dq.size; i++ {
+		index := (dq.front + i) % len(dq.items)
+		clone.PushBack(copyElem(dq.items[index]))
+	}
+
+	return clone
+}
+
+// CloneMap creates a copy of the deque with f applied to each element.
+// CloneMap is equivalent to CloneWith(f).
+// Time complexity: O(n) calls to f
+func (dq *Deque[T]) CloneMap(f func(T) T) *Deque[T] {
+	return dq.CloneWith(f)
+}
+
 // Capacity returns the current capacity of the underlying slice.
 func (dq *Deque[T]) Capacity() int {
 	return len(dq.items)
 }
 
 // Get returns the element at the specified index (0 is front).
+// Negative indices count from the back, so -1 is the back element and -Size() the front.
 // Time complexity: O(1)
 func (dq *Deque[T]) Get(index int) (T, error) {
 	var zero T
 
-	if index < 0 || index >= dq.size {
-		return zero, fmt.Errorf("index %d out of bounds for deque of size %d", index, dq.size)
+	offset, err := dq.normalizeIndex(index)
+	if err != nil {
+		return zero, err
 	}
 
-	actualIndex := (dq.front + index) % len(dq.items)
+	actualIndex := (dq.front + offset) % len(dq.items)
 	return dq.items[actualIndex], nil
 }
 
 // Set sets the element at the specified index (0 is front).
+// Negative indices count from the back, so -1 is the back element and -Size() the front.
 // Time complexity: O(1)
 func (dq *Deque[T]) Set(index int, value T) error {
-	if index < 0 || index >= dq.size {
-		return fmt.Errorf("index %d out of bounds for deque of size %d", index, dq.size)
+	offset, err := dq.normalizeIndex(index)
+	if err != nil {
+		return err
 	}
 
-	actualIndex := (dq.front + index) % len(dq.items)
+	actualIndex := (dq.front + offset) % len(dq.items)
 	dq.items[actualIndex] = value
 	return nil
 }
 
+// normalizeIndex converts a possibly negative index into an offset from the front,
+// returning an error if it falls outside the deque.
+func (dq *Deque[T]) normalizeIndex(index int) (int, error) {
+	offset := index
+	if offset < 0 {
+		offset += dq.size
+	}
+
+	if offset < 0 || offset >= dq.size {
+		return 0, fmt.Errorf("%w: index %d for deque of size %d", ErrIndexOutOfBounds, index, dq.size)
+	}
+
+	return offset, nil
+}
+
+// Slice returns a copy of the elements in the range [start, end), where 0 is the front.
+// Only the requested range is copied.
+// Returns an error if the range is invalid.
+// Time complexity: O(end - start)
+func (dq *Deque[T]) Slice(start, end int) ([]T, error) {
+	if start < 0 || end > dq.size || start > end {
+		return nil, fmt.Errorf("%w: range [%d, %d) for deque of size %d", ErrIndexOutOfBounds, start, end, dq.size)
+	}
+
+	result := make([]T, end-start)
+	for i := range result {
+		result[i] = dq.items[(dq.front+start+i)%len(dq.items)]
+	}
+
+	return result, nil
+}
+
+// InsertSorted inserts value at its sorted position, assuming the deque is already
+// sorted front-to-back according to less. Equal elements keep insertion order
+// (value goes after existing equal elements). Only the elements on the shorter
+// side of the insertion point are shifted, so inserting near either end is cheap.
+// Returns an error if less is nil.
+// Time complexity: O(log n) to locate, O(min(k, n-k)) to shift
+func (dq *Deque[T]) InsertSorted(value T, less func(a, b T) bool) error {
+	if less == nil {
+		return fmt.Errorf("%w: less function must not be nil", ErrInvalidArgument)
+	}
+
+	// Binary search for the first element greater than value
+	lo, hi := 0, dq.size
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if less(value, dq.items[(dq.front+mid)%len(dq.items)]) {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+	pos := lo
+
+	if dq.size == len(dq.items) {
+		dq.resize()
+	}
+
+	if pos < dq.size-pos {
+		// Shift the front part one slot towards the front
+		dq.front = (dq.front - 1 + len(dq.items)) % len(dq.items)
+		for i := 0; i < pos; i++ {
+			dq.items[(dq.front+i)%len(dq.items)] = dq.items[(dq.front+i+1)%len(dq.items)]
+		}
+	} else {
+		// Shift the back part one slot towards the back
+		for i := dq.size; i > pos; i-- {
+			dq.items[(dq.front+i)%len(dq.items)] = dq.items[(dq.front+i-1)%len(dq.items)]
+		}
+		dq.rear = (dq.rear + 1) % len(dq.items)
+	}
+
+	dq.items[(dq.front+pos)%len(dq.items)] = value
+	dq.size++
+	return nil
+}
+
 // Reverse reverses the order of elements in the deque.
 // Time complexity: O(n)
 func (dq *Deque[T]) Reverse() {
@@ -297,6 +696,95 @@ func (dq *Deque[T]) Reverse() {
 	}
 }
 
+// DequeReverseView is a read-only view of a Deque that presents its elements back to front.
+// The view holds no copy: it reads the underlying deque on every call, so it always
+// reflects the deque's current contents. An element pushed to the back of the deque
+// appears at the front of the view, and vice versa.
+type DequeReverseView[T any] struct {
+	deque *Deque[T]
+}
+
+// ReverseView returns a read-only view of the deque in reverse order without moving
+// any elements. Use Reverse when the deque itself must be reordered.
+// Time complexity: O(1)
+func (dq *Deque[T]) ReverseView() DequeReverseView[T] {
+	return DequeReverseView[T]{deque: dq}
+}
+
+// Get returns the element at the specified view index (0 is the deque's back).
+// Returns an error if the index is out of bounds.
+// Time complexity: O(1)
+func (v DequeReverseView[T]) Get(index int) (T, error) {
+	var zero T
+
+	if index < 0 || index >= v.deque.size {
+		return zero, fmt.Errorf("%w: index %d for deque of size %d", ErrIndexOutOfBounds, index, v.deque.size)
+	}
+
+	return v.deque.Get(v.deque.size - 1 - index)
+}
+
+// Front returns the first element of the view, which is the deque's back.
+// Returns an error if the deque is empty.
+// Time complexity: O(1)
+func (v DequeReverseView[T]) Front() (T, error) {
+	return v.deque.Back()
+}
+
+// Back returns the last element of the view, which is the deque's front.
+// Returns an error if the deque is empty.
+// Time complexity: O(1)
+func (v DequeReverseView[T]) Back() (T, error) {
+	return v.deque.Front()
+}
+
+// Size returns the number of elements in the underlying deque.
+// Time complexity: O(1)
+func (v DequeReverseView[T]) Size() int {
+	return v.deque.size
+}
+
+// IsEmpty returns true if the underlying deque is empty.
+// Time complexity: O(1)
+func (v DequeReverseView[T]) IsEmpty() bool {
+	return v.deque.size == 0
+}
+
+// ToSlice returns a copy of the elements in view order, back of the deque first.
+// Time complexity: O(n)
+func (v DequeReverseView[T]) ToSlice() []T {
+	return v.deque.ToReversedSlice()
+}
+
+// All returns an iterator over the elements in view order, back of the deque first.
+// The deque must not be modified during iteration.
+func (v DequeReverseView[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		dq := v.deque
+		for i := dq.size - 1; i >= 0; i-- {
+			if !yield(dq.items[(dq.front+i)%len(dq.items)]) {
+				return
+			}
+		}
+	}
+}
+
+// Sort sorts the elements of the deque so that the front is the least element per less.
+// The sort is stable: equal elements keep their relative front-to-back order.
+// The buffer is rebuilt with the front at index 0.
+// Time complexity: O(n log n)
+func (dq *Deque[T]) Sort(less func(a, b T) bool) {
+	slice := dq.ToSlice()
+	sort.SliceStable(slice, func(i, j int) bool {
+		return less(slice[i], slice[j])
+	})
+
+	clear(dq.items) // Drop stale references outside the rebuilt range
+	copy(dq.items, slice)
+	dq.front = 0
+	dq.rear = dq.size % len(dq.items)
+}
+
 // resize doubles the capacity when full, halves when 1/4 full
 func (dq *Deque[T]) resize() {
 	var newCapacity int
@@ -321,22 +809,162 @@ func (dq *Deque[T]) resize() {
 	dq.rear = dq.size
 }
 
+// reserve ensures there is room for n more elements, reallocating at most once.
+// The new capacity is the current capacity repeatedly multiplied by DequeGrowthFactor.
+func (dq *Deque[T]) reserve(n int) {
+	required := dq.size + n
+	if required <= len(dq.items) {
+		return
+	}
+
+	newCapacity := len(dq.items)
+	for newCapacity < required {
+		newCapacity *= DequeGrowthFactor
+	}
+
+	newItems := make([]T, newCapacity)
+
+	// Copy elements in order
+	for i := 0; i < dq.size; i++ {
+		index := (dq.front + i) % len(dq.items)
+		newItems[i] = dq.items[index]
+	}
+
+	dq.items = newItems
+	dq.front = 0
+	dq.rear = dq.size
+}
+
 // Rotate rotates the deque n positions to the right.
 // Negative n rotates to the left.
-// Time complexity: O(1)
+// Time complexity: O(1) when the buffer is full, otherwise O(min(n, size-n))
 func (dq *Deque[T]) Rotate(n int) {
 	if dq.size <= 1 {
 		return
 	}
 
-	// Normalize n to be within [-size, size]
+	// Normalize n to be within [0, size)
+	n %= dq.size
+	if n < 0 {
+		n += dq.size
+	}
+
+	if n == 0 {
+		return
+	}
+
+	if dq.size == len(dq.items) {
+		// Full buffer: rotating only changes where the sequence starts
+		dq.front = (dq.front - n + len(dq.items)) % len(dq.items)
+		dq.rear = dq.front
+		return
+	}
+
+	// Otherwise elements must cross the free gap; move whichever side is shorter
+	var zero T
+	if n <= dq.size/2 {
+		for i := 0; i < n; i++ {
+			dq.rear = (dq.rear - 1 + len(dq.items)) % len(dq.items)
+			dq.front = (dq.front - 1 + len(dq.items)) % len(dq.items)
+			dq.items[dq.front] = dq.items[dq.rear]
+			dq.items[dq.rear] = zero
+		}
+	} else {
+		for i := 0; i < dq.size-n; i++ {
+			dq.items[dq.rear] = dq.items[dq.front]
+			dq.items[dq.front] = zero
+			dq.rear = (dq.rear + 1) % len(dq.items)
+			dq.front = (dq.front + 1) % len(dq.items)
+		}
+	}
+}
+
+// RotateToFront rotates the deque left so the element currently at index becomes the
+// front, keeping the cyclic order of all elements. Like Get, negative indices count
+// from the back. Returns an error if index is out of bounds.
+// Only a full buffer can be rotated by moving front alone; with spare capacity the gap
+// between rear and front would end up in the middle of the elements, so they are moved.
+// Time complexity: O(1) when the buffer is full, otherwise O(min(k, size-k)) for index k
+func (dq *Deque[T]) RotateToFront(index int) error {
+	offset, err := dq.normalizeIndex(index)
+	if err != nil {
+		return err
+	}
+
+	dq.Rotate(-offset)
+	return nil
+}
+
+// RotateElements rotates the deque n positions to the right like Rotate, but moves the
+// elements within the backing array so the front element ends up at index 0 and the
+// elements are stored contiguously. The logical contents afterwards are identical to
+// Rotate(n). Negative n rotates to the left. The backing array is reused.
+// Time complexity: O(c) where c is the capacity
+func (dq *Deque[T]) RotateElements(n int) {
+	if dq.size == 0 {
+		dq.front, dq.rear = 0, 0
+		return
+	}
+
+	// Normalize n to be within [0, size)
 	n %= dq.size
 	if n < 0 {
 		n += dq.size
 	}
 
-	// Adjust front pointer
-	dq.front = (dq.front - n + len(dq.items)) % len(dq.items)
+	// Rotate the whole buffer left by front so the elements start at index 0,
+	// then rotate the occupied prefix right by n, each with three reversals
+	slices.Reverse(dq.items[:dq.front])
+	slices.Reverse(dq.items[dq.front:])
+	slices.Reverse(dq.items)
+
+	slices.Reverse(dq.items[:dq.size])
+	slices.Reverse(dq.items[:n])
+	slices.Reverse(dq.items[n:dq.size])
+
+	dq.front = 0
+	dq.rear = dq.size % len(dq.items)
+}
+
+// RotateUntil rotates the deque to the left until the front element satisfies pred.
+// Returns true if a matching element was found and is now at the front.
+// If no element matches, the deque is left in its original order and false is returned.
+// Time complexity: O(n)
+func (dq *Deque[T]) RotateUntil(pred func(T) bool) bool {
+	for i := 0; i < dq.size; i++ {
+		if pred(dq.items[(dq.front+i)%len(dq.items)]) {
+			dq.Rotate(-i)
+			return true
+		}
+	}
+
+	return false
+}
+
+// Windows returns every contiguous window of k elements in front-to-back order.
+// Each window is an independent copy. If k exceeds the size, no windows are returned.
+// Returns nil if k is not positive.
+// Time complexity: O(k * (n - k + 1))
+func (dq *Deque[T]) Windows(k int) [][]T {
+	if k <= 0 {
+		return nil
+	}
+
+	if k > dq.size {
+		return [][]T{}
+	}
+
+	result := make([][]T, 0, dq.size-k+1)
+	for start := 0; start+k <= dq.size; start++ {
+		window := make([]T, k)
+		for i := 0; i < k; i++ {
+			index := (dq.front + start + i) % len(dq.items)
+			window[i] = dq.items[index]
+		}
+		result = append(result, window)
+	}
+
+	return result
 }
 
 // PeekFront returns the front element (alias for Front).
@@ -368,3 +996,34 @@ func (dq *Deque[T]) Push(value T) {
 func (dq *Deque[T]) Pop() (T, error) {
 	return dq.PopBack()
 }
+
+// BinarySearch searches a deque sorted front-to-back in ascending order per cmp.
+// cmp(a, b) must return a negative number when a < b, zero when equal and positive when a > b.
+// Returns the index of the first element equal to target and true if found; otherwise
+// returns the index where target would be inserted to keep the order, and false.
+// Time complexity: O(log n)
+func BinarySearch[T any](dq *Deque[T], target T, cmp func(a, b T) int) (int, bool) {
+	lo, hi := 0, dq.size
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if cmp(dq.items[(dq.front+mid)%len(dq.items)], target) < 0 {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+
+	found := lo < dq.size && cmp(dq.items[(dq.front+lo)%len(dq.items)], target) == 0
+	return lo, found
+}
+
+// FoldDeque combines the elements of dq from front to back into a single value,
+// starting from init and applying f to the running result and each element.
+// Time complexity: O(n)
+func FoldDeque[T, R any](dq *Deque[T], init R, f func(R, T) R) R {
+	result := init
+	for i := 0; i < dq.size; i++ {
+		result = f(result, dq.items[(dq.front+i)%len(dq.items)])
+	}
+	return result
+}
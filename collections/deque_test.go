@@ -1,10 +1,30 @@
 package collections
 
 import (
+	"cmp"
+	"errors"
 	"reflect"
+	"slices"
 	"testing"
 )
 
+// newWrappedDeque returns a deque holding values with spare capacity whose front
+// sits at the last slot of the buffer, so any two or more values wrap around the end.
+func newWrappedDeque[T any](t *testing.T, values ...T) *Deque[T] {
+	t.Helper()
+
+	dq := NewDequeWithCapacity[T](max(2*len(values), 4))
+	if len(values) > 0 {
+		dq.PushBackN(values[1:]...)
+		dq.PushFront(values[0])
+	}
+
+	if len(values) > 1 && dq.front+dq.size <= len(dq.items) {
+		t.Fatalf("expected a wrapped buffer, got front %d of %d", dq.front, len(dq.items))
+	}
+	return dq
+}
+
 func TestNewDeque(t *testing.T) {
 	dq := NewDeque[int]()
 
@@ -62,6 +82,33 @@ func TestFromSliceDeque(t *testing.T) {
 	}
 }
 
+func TestDequeOf(t *testing.T) {
+	// Construction is covered by TestListOf; this checks both ends
+	dq := DequeOf(1, 2, 3)
+	front, _ := dq.Front()
+	back, _ := dq.Back()
+	if front != 1 || back != 3 {
+		t.Errorf("expected front 1 and back 3, got %d and %d", front, back)
+	}
+}
+
+func TestFromSeqDeque(t *testing.T) {
+	// Sequence handling is covered by TestFromSeq; this grows past the initial buffer
+	values := make([]int, 2*DequeInitialCapacity+1)
+	for i := range values {
+		values[i] = i
+	}
+	c := FromSeqDeque(slices.Values(values))
+
+	if !reflect.DeepEqual(c.ToSlice(), values) {
+		t.Errorf("expected %v, got %v", values, c.ToSlice())
+	}
+
+	if value, err := c.PopBack(); err != nil || value != len(values)-1 {
+		t.Errorf("expected %d from PopBack, got %d, error=%v", len(values)-1, value, err)
+	}
+}
+
 func TestPushFront(t *testing.T) {
 	dq := NewDeque[int]()
 
@@ -118,6 +165,172 @@ func TestPushBack(t *testing.T) {
 	}
 }
 
+func TestPushBackN(t *testing.T) {
+	dq := FromSliceDeque([]int{1, 2})
+	dq.PushBackN(3, 4, 5)
+
+	expected := []int{1, 2, 3, 4, 5}
+	result := dq.ToSlice()
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+
+	back, _ := dq.Back()
+	if back != 5 {
+		t.Errorf("expected back=5, got %d", back)
+	}
+}
+
+func TestPushFrontN(t *testing.T) {
+	dq := FromSliceDeque([]int{4, 5})
+	dq.PushFrontN(3, 2, 1)
+
+	// Last-passed value ends up frontmost
+	expected := []int{1, 2, 3, 4, 5}
+	result := dq.ToSlice()
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+
+	// Must match repeated PushFront calls
+	reference := FromSliceDeque([]int{4, 5})
+	for _, v := range []int{3, 2, 1} {
+		reference.PushFront(v)
+	}
+
+	if !reflect.DeepEqual(result, reference.ToSlice()) {
+		t.Errorf("expected %v to match repeated PushFront %v", result, reference.ToSlice())
+	}
+}
+
+func TestPushNWrappedBuffer(t *testing.T) {
+	dq := newWrappedDeque(t, 1, 2)
+
+	dq.PushBackN(3, 4, 5, 6)
+	dq.PushFrontN(0, -1)
+
+	expected := []int{-1, 0, 1, 2, 3, 4, 5, 6}
+	result := dq.ToSlice()
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestPushNSingleResize(t *testing.T) {
+	values := make([]int, 1000)
+	for i := range values {
+		values[i] = i
+	}
+
+	// Allocations for the bulk push beyond creating the deque itself
+	var dq *Deque[int]
+	baseline := testing.AllocsPerRun(10, func() {
+		dq = NewDeque[int]()
+	})
+
+	tests := []struct {
+		name string
+		push func(dq *Deque[int])
+	}{
+		{"push back", func(dq *Deque[int]) { dq.PushBackN(values...) }},
+		{"push front", func(dq *Deque[int]) { dq.PushFrontN(values...) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			allocs := testing.AllocsPerRun(10, func() {
+				dq = NewDeque[int]()
+				tt.push(dq)
+			})
+
+			if allocs-baseline != 1 {
+				t.Errorf("expected exactly 1 resize allocation, got %v", allocs-baseline)
+			}
+
+			dq = NewDeque[int]()
+			tt.push(dq)
+			if dq.Size() != len(values) {
+				t.Errorf("expected size %d, got %d", len(values), dq.Size())
+			}
+		})
+	}
+}
+
+func TestFromSliceDequeSingleAllocation(t *testing.T) {
+	values := make([]int, 100000)
+
+	// NewDeque allocates the struct and one buffer; a bulk load should need no more
+	var dq *Deque[int]
+	baseline := testing.AllocsPerRun(10, func() {
+		dq = NewDeque[int]()
+	})
+
+	allocs := testing.AllocsPerRun(10, func() {
+		dq = FromSliceDeque(values)
+	})
+
+	if allocs != baseline {
+		t.Errorf("expected %v allocations (one buffer), got %v", baseline, allocs)
+	}
+
+	if dq.Capacity() != len(values) {
+		t.Errorf("expected capacity sized exactly to %d, got %d", len(values), dq.Capacity())
+	}
+}
+
+func TestFrontBackRef(t *testing.T) {
+	type record struct {
+		id    int
+		count int
+	}
+
+	dq := FromSliceDeque([]record{{id: 1}, {id: 2}, {id: 3}})
+
+	front, err := dq.FrontRef()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	front.count = 10
+
+	back, err := dq.BackRef()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	back.count = 30
+
+	first, _ := dq.Get(0)
+	if first.count != 10 {
+		t.Errorf("expected Get(0).count=10, got %d", first.count)
+	}
+
+	last, _ := dq.Get(2)
+	if last.count != 30 {
+		t.Errorf("expected Get(2).count=30, got %d", last.count)
+	}
+
+	// Empty deque
+	empty := NewDeque[record]()
+	if ref, err := empty.FrontRef(); err == nil || ref != nil {
+		t.Error("expected error and nil pointer for FrontRef on empty deque")
+	}
+	if ref, err := empty.BackRef(); err == nil || ref != nil {
+		t.Error("expected error and nil pointer for BackRef on empty deque")
+	}
+}
+
+func TestPushBackSeq(t *testing.T) {
+	dq := FromSliceDeque([]int{0})
+	dq.PushBackSeq(slices.Values([]int{1, 2, 3, 4, 5}))
+
+	expected := []int{0, 1, 2, 3, 4, 5}
+	if !reflect.DeepEqual(dq.ToSlice(), expected) {
+		t.Errorf("expected %v, got %v", expected, dq.ToSlice())
+	}
+}
+
 func TestDequeGetSet(t *testing.T) {
 	dq := FromSliceDeque([]int{10, 20, 30, 40})
 
@@ -131,7 +344,9 @@ func TestDequeGetSet(t *testing.T) {
 		{"get first", 0, 10, false},
 		{"get middle", 2, 30, false},
 		{"get last", 3, 40, false},
-		{"invalid negative", -1, 0, true},
+		{"negative last", -1, 40, false},
+		{"negative front", -4, 10, false},
+		{"invalid negative", -5, 0, true},
 		{"invalid large", 4, 0, true},
 	}
 
@@ -173,6 +388,165 @@ func TestDequeGetSet(t *testing.T) {
 	if err == nil {
 		t.Error("expected error for invalid index")
 	}
+
+	// Test negative set
+	if err := dq.Set(-1, 77); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if back, _ := dq.Back(); back != 77 {
+		t.Errorf("expected back 77 after Set(-1), got %d", back)
+	}
+
+	if err := dq.Set(-5, 100); !errors.Is(err, ErrIndexOutOfBounds) {
+		t.Errorf("expected ErrIndexOutOfBounds for out-of-range negative index, got %v", err)
+	}
+}
+
+func TestDequeGetNegativeWrapped(t *testing.T) {
+	dq := newWrappedDeque(t, 1, 2, 3)
+
+	for index, expected := range map[int]int{-1: 3, -2: 2, -3: 1} {
+		if value, err := dq.Get(index); err != nil || value != expected {
+			t.Errorf("expected %d at index %d, got %d, error=%v", expected, index, value, err)
+		}
+	}
+
+	if _, err := NewDeque[int]().Get(-1); !errors.Is(err, ErrIndexOutOfBounds) {
+		t.Errorf("expected ErrIndexOutOfBounds on empty deque, got %v", err)
+	}
+}
+
+func TestInsertSorted(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+
+	tests := []struct {
+		name     string
+		input    []int
+		expected []int
+	}{
+		{"shuffled", []int{5, 1, 9, 3, 7, 2, 8, 4, 6, 0}, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}},
+		{"ascending input", []int{1, 2, 3, 4, 5}, []int{1, 2, 3, 4, 5}},
+		{"descending input", []int{5, 4, 3, 2, 1}, []int{1, 2, 3, 4, 5}},
+		{"duplicates", []int{3, 1, 3, 2, 1}, []int{1, 1, 2, 3, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dq := NewDeque[int]()
+			for _, v := range tt.input {
+				if err := dq.InsertSorted(v, less); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+
+			if !reflect.DeepEqual(dq.ToSlice(), tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, dq.ToSlice())
+			}
+
+			front, _ := dq.Front()
+			back, _ := dq.Back()
+			if front != tt.expected[0] || back != tt.expected[len(tt.expected)-1] {
+				t.Errorf("expected min=%d max=%d at ends, got front=%d back=%d",
+					tt.expected[0], tt.expected[len(tt.expected)-1], front, back)
+			}
+		})
+	}
+}
+
+func TestInsertSortedStable(t *testing.T) {
+	type record struct {
+		key   int
+		label string
+	}
+	less := func(a, b record) bool { return a.key < b.key }
+
+	dq := NewDeque[record]()
+	for _, r := range []record{{2, "a"}, {1, "b"}, {2, "c"}, {1, "d"}} {
+		dq.InsertSorted(r, less)
+	}
+
+	expected := []record{{1, "b"}, {1, "d"}, {2, "a"}, {2, "c"}}
+	if !reflect.DeepEqual(dq.ToSlice(), expected) {
+		t.Errorf("expected %v, got %v", expected, dq.ToSlice())
+	}
+
+	if err := dq.InsertSorted(record{}, nil); err == nil {
+		t.Error("expected error for nil less function")
+	}
+}
+
+func TestDequeSlice(t *testing.T) {
+	dq := newWrappedDeque(t, 0, 1, 2, 3, 4, 5, 6)
+
+	tests := []struct {
+		name      string
+		start     int
+		end       int
+		expected  []int
+		expectErr bool
+	}{
+		{"middle range", 2, 5, []int{2, 3, 4}, false},
+		{"full range", 0, 7, []int{0, 1, 2, 3, 4, 5, 6}, false},
+		{"empty range", 3, 3, []int{}, false},
+		{"negative start", -1, 2, nil, true},
+		{"end past size", 5, 8, nil, true},
+		{"start after end", 4, 2, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := dq.Slice(tt.start, tt.end)
+
+			if tt.expectErr {
+				if err == nil {
+					t.Error("expected error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestBinarySearch(t *testing.T) {
+	dq := newWrappedDeque(t, 10, 20, 30, 40, 50, 50)
+
+	tests := []struct {
+		name          string
+		target        int
+		expectedIndex int
+		expectedFound bool
+	}{
+		{"first element", 10, 0, true},
+		{"middle element", 30, 2, true},
+		{"duplicate returns first", 50, 4, true},
+		{"absent middle", 35, 3, false},
+		{"absent before front", 5, 0, false},
+		{"absent after back", 60, 6, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			index, found := BinarySearch(dq, tt.target, cmp.Compare[int])
+
+			if index != tt.expectedIndex || found != tt.expectedFound {
+				t.Errorf("expected (%d, %t), got (%d, %t)", tt.expectedIndex, tt.expectedFound, index, found)
+			}
+		})
+	}
+
+	if index, found := BinarySearch(NewDeque[int](), 1, cmp.Compare[int]); index != 0 || found {
+		t.Errorf("expected (0, false) on empty deque, got (%d, %t)", index, found)
+	}
 }
 
 func TestDequeContains(t *testing.T) {
@@ -212,69 +586,446 @@ func TestDequeClone(t *testing.T) {
 	// Check that they are independent
 	clone.PushBack(4)
 
-	if original.Size() == clone.Size() {
-		t.Error("clone should be independent of original")
+	if original.Size() == clone.Size() {
+		t.Error("clone should be independent of original")
+	}
+}
+
+func TestDequeCloneWith(t *testing.T) {
+	a, b := &Node[int]{Value: 1}, &Node[int]{Value: 2}
+	dq := FromSliceDeque([]*Node[int]{a, b})
+
+	shallow := dq.Clone()
+	if front, _ := shallow.Front(); front != a {
+		t.Error("expected Clone to share element pointers")
+	}
+
+	clone := dq.CloneWith(func(n *Node[int]) *Node[int] {
+		c := *n
+		return &c
+	})
+
+	if clone.Size() != 2 {
+		t.Fatalf("expected size 2, got %d", clone.Size())
+	}
+
+	for i, orig := range []*Node[int]{a, b} {
+		got, _ := clone.Get(i)
+		if got == orig {
+			t.Errorf("expected distinct object at index %d", i)
+		}
+		if got.Value != orig.Value {
+			t.Errorf("expected value %d at index %d, got %d", orig.Value, i, got.Value)
+		}
+	}
+
+	// Mutating the copy must not affect the original
+	got, _ := clone.Get(0)
+	got.Value = 100
+	if a.Value != 1 {
+		t.Errorf("expected original unchanged, got %d", a.Value)
+	}
+}
+
+func TestDequeCloneMap(t *testing.T) {
+	double := func(v int) int { return v * 2 }
+	c := DequeOf(1, 2, 3)
+
+	result, expected := c.CloneMap(double).ToSlice(), c.CloneWith(double).ToSlice()
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestDequeReverse(t *testing.T) {
+	tests := []struct {
+		name     string
+		initial  []int
+		expected []int
+	}{
+		{"empty deque", []int{}, []int{}},
+		{"single element", []int{1}, []int{1}},
+		{"two elements", []int{1, 2}, []int{2, 1}},
+		{"multiple elements", []int{1, 2, 3, 4}, []int{4, 3, 2, 1}},
+		{"odd elements", []int{1, 2, 3, 4, 5}, []int{5, 4, 3, 2, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dq := FromSliceDeque(tt.initial)
+			dq.Reverse()
+			result := dq.ToSlice()
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestDequeToReversedSlice(t *testing.T) {
+	wrapped := newWrappedDeque(t, 1, 2, 3, 4)
+
+	tests := []struct {
+		name string
+		dq   *Deque[int]
+	}{
+		{"empty deque", NewDeque[int]()},
+		{"single element", FromSliceDeque([]int{1})},
+		{"multiple elements", FromSliceDeque([]int{1, 2, 3, 4, 5})},
+		{"wrapped buffer", wrapped},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := tt.dq.ToSlice()
+			result := tt.dq.ToReversedSlice()
+
+			reference := tt.dq.Clone()
+			reference.Reverse()
+
+			if !reflect.DeepEqual(result, reference.ToSlice()) {
+				t.Errorf("expected %v, got %v", reference.ToSlice(), result)
+			}
+
+			// Original must be unchanged
+			if !reflect.DeepEqual(tt.dq.ToSlice(), before) {
+				t.Errorf("expected deque unchanged %v, got %v", before, tt.dq.ToSlice())
+			}
+		})
+	}
+}
+
+func TestDequeSort(t *testing.T) {
+	ascending := func(a, b int) bool { return a < b }
+	descending := func(a, b int) bool { return a > b }
+
+	tests := []struct {
+		name     string
+		initial  []int
+		less     func(a, b int) bool
+		expected []int
+	}{
+		{"ascending", []int{4, 7, 1, 3, 6}, ascending, []int{1, 3, 4, 6, 7}},
+		{"descending", []int{4, 7, 1, 3, 6}, descending, []int{7, 6, 4, 3, 1}},
+		{"already sorted", []int{1, 2, 3}, ascending, []int{1, 2, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dq := newWrappedDeque(t, tt.initial...)
+
+			dq.Sort(tt.less)
+
+			if !reflect.DeepEqual(dq.ToSlice(), tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, dq.ToSlice())
+			}
+
+			if dq.front != 0 || dq.rear != dq.size%len(dq.items) {
+				t.Errorf("expected front=0 and rear=%d, got front=%d rear=%d", dq.size%len(dq.items), dq.front, dq.rear)
+			}
+
+			dq.PushBack(100)
+			dq.PushFront(-100)
+			if back, _ := dq.Back(); back != 100 {
+				t.Errorf("expected back=100, got %d", back)
+			}
+			if front, _ := dq.Front(); front != -100 {
+				t.Errorf("expected front=-100, got %d", front)
+			}
+		})
+	}
+}
+
+func TestFromSliceDequeFullBuffer(t *testing.T) {
+	dq := FromSliceDeque([]int{1, 2, 3, 4})
+	dq.PopFront()
+	dq.PushBack(5)
+
+	expected := []int{2, 3, 4, 5}
+	if !reflect.DeepEqual(dq.ToSlice(), expected) {
+		t.Errorf("expected %v, got %v", expected, dq.ToSlice())
+	}
+}
+
+func TestDequeAll(t *testing.T) {
+	dq := NewDequeWithCapacity[int](4)
+	dq.PushBack(3)
+	dq.PushFront(2)
+	dq.PushFront(1)
+
+	result := slices.Collect(dq.All())
+	if !reflect.DeepEqual(result, []int{1, 2, 3}) {
+		t.Errorf("expected [1 2 3], got %v", result)
+	}
+}
+
+func TestRotate(t *testing.T) {
+	dq := FromSliceDeque([]int{1, 2, 3, 4, 5})
+
+	tests := []struct {
+		name     string
+		n        int
+		expected []int
+	}{
+		{"rotate right 1", 1, []int{5, 1, 2, 3, 4}},
+		{"rotate right 2", 2, []int{4, 5, 1, 2, 3}},
+		{"rotate left 1", -1, []int{2, 3, 4, 5, 1}},
+		{"rotate left 2", -2, []int{3, 4, 5, 1, 2}},
+		{"rotate full cycle", 5, []int{1, 2, 3, 4, 5}},
+		{"rotate 0", 0, []int{1, 2, 3, 4, 5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Reset deque
+			dq.Clear()
+			for _, v := range []int{1, 2, 3, 4, 5} {
+				dq.PushBack(v)
+			}
+
+			dq.Rotate(tt.n)
+			result := dq.ToSlice()
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestWindows(t *testing.T) {
+	dq := FromSliceDeque([]int{1, 2, 3, 4})
+
+	tests := []struct {
+		name     string
+		k        int
+		expected [][]int
+	}{
+		{"size 2", 2, [][]int{{1, 2}, {2, 3}, {3, 4}}},
+		{"size 1", 1, [][]int{{1}, {2}, {3}, {4}}},
+		{"full size", 4, [][]int{{1, 2, 3, 4}}},
+		{"larger than size", 5, [][]int{}},
+		{"zero", 0, nil},
+		{"negative", -1, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := dq.Windows(tt.k)
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestWindowsWrappedBuffer(t *testing.T) {
+	dq := newWrappedDeque(t, 1, 2, 3, 4)
+
+	result := dq.Windows(3)
+
+	expected := [][]int{{1, 2, 3}, {2, 3, 4}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+
+	// Windows are copies
+	result[0][0] = 100
+	if front, _ := dq.Front(); front != 1 {
+		t.Errorf("expected deque unchanged, front=%d", front)
 	}
 }
 
-func TestDequeReverse(t *testing.T) {
+func TestRotatePartialBuffer(t *testing.T) {
 	tests := []struct {
 		name     string
-		initial  []int
+		n        int
 		expected []int
 	}{
-		{"empty deque", []int{}, []int{}},
-		{"single element", []int{1}, []int{1}},
-		{"two elements", []int{1, 2}, []int{2, 1}},
-		{"multiple elements", []int{1, 2, 3, 4}, []int{4, 3, 2, 1}},
-		{"odd elements", []int{1, 2, 3, 4, 5}, []int{5, 4, 3, 2, 1}},
+		{"rotate right 1", 1, []int{5, 1, 2, 3, 4}},
+		{"rotate right 4", 4, []int{2, 3, 4, 5, 1}},
+		{"rotate left 2", -2, []int{3, 4, 5, 1, 2}},
+		{"rotate left 3", -3, []int{4, 5, 1, 2, 3}},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			dq := FromSliceDeque(tt.initial)
-			dq.Reverse()
-			result := dq.ToSlice()
+			// Capacity larger than size so rotation must move elements across the gap
+			dq := NewDequeWithCapacity[int](8)
+			dq.PushBackN(3, 4, 5)
+			dq.PushFrontN(2, 1)
 
-			if !reflect.DeepEqual(result, tt.expected) {
-				t.Errorf("expected %v, got %v", tt.expected, result)
+			dq.Rotate(tt.n)
+
+			if !reflect.DeepEqual(dq.ToSlice(), tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, dq.ToSlice())
+			}
+
+			// Both ends must remain consistent after rotation
+			back, _ := dq.PopBack()
+			if back != tt.expected[len(tt.expected)-1] {
+				t.Errorf("expected back=%d, got %d", tt.expected[len(tt.expected)-1], back)
+			}
+			dq.PushBack(100)
+			dq.PushFront(-100)
+
+			expected := append([]int{-100}, tt.expected[:len(tt.expected)-1]...)
+			expected = append(expected, 100)
+			if !reflect.DeepEqual(dq.ToSlice(), expected) {
+				t.Errorf("expected %v after push, got %v", expected, dq.ToSlice())
 			}
 		})
 	}
 }
 
-func TestRotate(t *testing.T) {
-	dq := FromSliceDeque([]int{1, 2, 3, 4, 5})
+func TestRotateFullBufferPopBack(t *testing.T) {
+	dq := FromSliceDeque([]int{1, 2, 3, 4})
+	dq.Rotate(1)
+
+	back, _ := dq.PopBack()
+	if back != 3 {
+		t.Errorf("expected back=3 after rotate, got %d", back)
+	}
+
+	expected := []int{4, 1, 2}
+	if !reflect.DeepEqual(dq.ToSlice(), expected) {
+		t.Errorf("expected %v, got %v", expected, dq.ToSlice())
+	}
+}
+
+func TestRotateToFront(t *testing.T) {
+	// full builds a deque whose buffer is exactly full, so rotation is a pointer move
+	full := func() *Deque[int] {
+		dq := NewDequeWithCapacity[int](4)
+		dq.PushBackN(1, 2, 3, 4)
+		return dq
+	}
+
+	// partial builds a deque with spare capacity whose contents wrap the buffer end
+	partial := func() *Deque[int] {
+		return newWrappedDeque(t, 1, 2, 3, 4)
+	}
 
 	tests := []struct {
 		name     string
-		n        int
+		build    func() *Deque[int]
+		index    int
 		expected []int
 	}{
-		{"rotate right 1", 1, []int{5, 1, 2, 3, 4}},
-		{"rotate right 2", 2, []int{4, 5, 1, 2, 3}},
-		{"rotate left 1", -1, []int{2, 3, 4, 5, 1}},
-		{"rotate left 2", -2, []int{3, 4, 5, 1, 2}},
-		{"rotate full cycle", 5, []int{1, 2, 3, 4, 5}},
-		{"rotate 0", 0, []int{1, 2, 3, 4, 5}},
+		{"full index 0", full, 0, []int{1, 2, 3, 4}},
+		{"full index 1", full, 1, []int{2, 3, 4, 1}},
+		{"full index 3", full, 3, []int{4, 1, 2, 3}},
+		{"full negative", full, -2, []int{3, 4, 1, 2}},
+		{"partial index 1", partial, 1, []int{2, 3, 4, 1}},
+		{"partial index 3", partial, 3, []int{4, 1, 2, 3}},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			// Reset deque
-			dq.Clear()
-			for _, v := range []int{1, 2, 3, 4, 5} {
-				dq.PushBack(v)
+			dq := tt.build()
+			if err := dq.RotateToFront(tt.index); err != nil {
+				t.Fatalf("unexpected error: %v", err)
 			}
 
-			dq.Rotate(tt.n)
-			result := dq.ToSlice()
-
-			if !reflect.DeepEqual(result, tt.expected) {
+			if result := dq.ToSlice(); !reflect.DeepEqual(result, tt.expected) {
 				t.Errorf("expected %v, got %v", tt.expected, result)
 			}
+
+			// The rear must stay consistent with the new front
+			dq.PushBack(5)
+			dq.PushFront(0)
+			expected := append(append([]int{0}, tt.expected...), 5)
+			if result := dq.ToSlice(); !reflect.DeepEqual(result, expected) {
+				t.Errorf("expected %v after pushes, got %v", expected, result)
+			}
+
+			if back, _ := dq.Back(); back != 5 {
+				t.Errorf("expected back 5, got %d", back)
+			}
 		})
 	}
+
+	for _, index := range []int{4, -5} {
+		dq := full()
+		if err := dq.RotateToFront(index); !errors.Is(err, ErrIndexOutOfBounds) {
+			t.Errorf("expected ErrIndexOutOfBounds for index %d, got %v", index, err)
+		}
+		if expected := []int{1, 2, 3, 4}; !reflect.DeepEqual(dq.ToSlice(), expected) {
+			t.Errorf("expected unchanged deque %v, got %v", expected, dq.ToSlice())
+		}
+	}
+
+	if err := NewDeque[int]().RotateToFront(0); !errors.Is(err, ErrIndexOutOfBounds) {
+		t.Errorf("expected ErrIndexOutOfBounds on empty deque, got %v", err)
+	}
+}
+
+func TestRotateToFrontPartialPushBack(t *testing.T) {
+	// Non-full and wrapped, so the rotation has to move elements rather than front
+	dq := newWrappedDeque(t, 1, 2, 3, 4, 5)
+	if err := dq.RotateToFront(2); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	dq.PushBack(6)
+
+	if expected := []int{3, 4, 5, 1, 2, 6}; !reflect.DeepEqual(dq.ToSlice(), expected) {
+		t.Errorf("expected %v, got %v", expected, dq.ToSlice())
+	}
+
+	if back, err := dq.Back(); err != nil || back != 6 {
+		t.Errorf("expected back 6, got %d, error=%v", back, err)
+	}
+}
+
+func TestRotateUntil(t *testing.T) {
+	t.Run("match", func(t *testing.T) {
+		dq := FromSliceDeque([]int{1, 2, 3, 4, 5})
+
+		if !dq.RotateUntil(func(v int) bool { return v == 4 }) {
+			t.Fatal("expected a match")
+		}
+
+		expected := []int{4, 5, 1, 2, 3}
+		if !reflect.DeepEqual(dq.ToSlice(), expected) {
+			t.Errorf("expected %v, got %v", expected, dq.ToSlice())
+		}
+	})
+
+	t.Run("front already matches", func(t *testing.T) {
+		dq := FromSliceDeque([]int{2, 3, 1})
+
+		if !dq.RotateUntil(func(v int) bool { return v%2 == 0 }) {
+			t.Fatal("expected a match")
+		}
+
+		if !reflect.DeepEqual(dq.ToSlice(), []int{2, 3, 1}) {
+			t.Errorf("expected unchanged order, got %v", dq.ToSlice())
+		}
+	})
+
+	t.Run("no match restores order", func(t *testing.T) {
+		dq := NewDequeWithCapacity[int](8)
+		dq.PushBackN(1, 2, 3)
+
+		if dq.RotateUntil(func(v int) bool { return v > 10 }) {
+			t.Fatal("expected no match")
+		}
+
+		if !reflect.DeepEqual(dq.ToSlice(), []int{1, 2, 3}) {
+			t.Errorf("expected original order, got %v", dq.ToSlice())
+		}
+	})
+
+	t.Run("empty deque", func(t *testing.T) {
+		dq := NewDeque[int]()
+
+		if dq.RotateUntil(func(int) bool { return true }) {
+			t.Error("expected false for empty deque")
+		}
+	})
 }
 
 func TestAliases(t *testing.T) {
@@ -363,6 +1114,28 @@ func TestDequeString(t *testing.T) {
 	}
 }
 
+func TestDequeGoString(t *testing.T) {
+	tests := []struct {
+		name     string
+		initial  []string
+		expected string
+	}{
+		{"empty deque", []string{}, "Deque[]"},
+		{"comma inside element", []string{"a, b", "c"}, `Deque["a, b", "c"] (front -> back)`},
+		{"brackets inside element", []string{"]["}, `Deque["]["] (front -> back)`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dq := FromSliceDeque(tt.initial)
+
+			if result := dq.GoString(); result != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, result)
+			}
+		})
+	}
+}
+
 func TestDequeAsStackAndQueue(t *testing.T) {
 	// Test as stack (LIFO)
 	stack := NewDeque[int]()
@@ -399,6 +1172,297 @@ func TestDequeAsStackAndQueue(t *testing.T) {
 	}
 }
 
+func TestDequeFindIndex(t *testing.T) {
+	dq := newWrappedDeque(t, 1, 2, 3, 4, 5, 6)
+
+	isEven := func(v int) bool { return v%2 == 0 }
+
+	tests := []struct {
+		name  string
+		pred  func(int) bool
+		first int
+		last  int
+	}{
+		{"even", isEven, 1, 5},
+		{"greater than 3", func(v int) bool { return v > 3 }, 3, 5},
+		{"exactly one match", func(v int) bool { return v == 1 }, 0, 0},
+		{"no match", func(v int) bool { return v > 10 }, -1, -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := dq.FindIndex(tt.pred); got != tt.first {
+				t.Errorf("expected first index %d, got %d", tt.first, got)
+			}
+			if got := dq.FindLastIndex(tt.pred); got != tt.last {
+				t.Errorf("expected last index %d, got %d", tt.last, got)
+			}
+		})
+	}
+
+	if NewDeque[int]().FindIndex(isEven) != -1 || NewDeque[int]().FindLastIndex(isEven) != -1 {
+		t.Error("expected -1 for empty deque")
+	}
+}
+
+func TestDequeTruncate(t *testing.T) {
+	tests := []struct {
+		name      string
+		n         int
+		keepFront []int
+		keepBack  []int
+	}{
+		{"smaller size", 2, []int{1, 2}, []int{4, 5}},
+		{"zero", 0, []int{}, []int{}},
+		{"negative", -3, []int{}, []int{}},
+		{"equal to size", 5, []int{1, 2, 3, 4, 5}, []int{1, 2, 3, 4, 5}},
+		{"exceeds size", 10, []int{1, 2, 3, 4, 5}, []int{1, 2, 3, 4, 5}},
+	}
+
+	build := func() *Deque[int] {
+		return newWrappedDeque(t, 1, 2, 3, 4, 5)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dq := build()
+			dq.Truncate(tt.n)
+			if !reflect.DeepEqual(dq.ToSlice(), tt.keepFront) {
+				t.Errorf("Truncate: expected %v, got %v", tt.keepFront, dq.ToSlice())
+			}
+			if dq.Capacity() != 10 {
+				t.Errorf("Truncate: expected capacity 10, got %d", dq.Capacity())
+			}
+
+			// The deque must remain usable at both ends
+			dq.PushBack(6)
+			dq.PushFront(0)
+			expected := append(append([]int{0}, tt.keepFront...), 6)
+			if !reflect.DeepEqual(dq.ToSlice(), expected) {
+				t.Errorf("Truncate: expected %v after pushes, got %v", expected, dq.ToSlice())
+			}
+
+			dq = build()
+			dq.TruncateFront(tt.n)
+			if !reflect.DeepEqual(dq.ToSlice(), tt.keepBack) {
+				t.Errorf("TruncateFront: expected %v, got %v", tt.keepBack, dq.ToSlice())
+			}
+			if dq.Capacity() != 10 {
+				t.Errorf("TruncateFront: expected capacity 10, got %d", dq.Capacity())
+			}
+
+			dq.PushBack(6)
+			dq.PushFront(0)
+			expected = append(append([]int{0}, tt.keepBack...), 6)
+			if !reflect.DeepEqual(dq.ToSlice(), expected) {
+				t.Errorf("TruncateFront: expected %v after pushes, got %v", expected, dq.ToSlice())
+			}
+		})
+	}
+
+	// Discarded slots no longer hold references
+	dq := FromSliceDeque([]*int{new(int), new(int), new(int)})
+	dq.Truncate(1)
+	for i := 1; i < len(dq.items); i++ {
+		if dq.items[(dq.front+i)%len(dq.items)] != nil {
+			t.Errorf("expected cleared slot at offset %d", i)
+		}
+	}
+}
+
+func TestDequeRotateElements(t *testing.T) {
+	build := func() *Deque[int] {
+		return newWrappedDeque(t, 1, 2, 3, 4, 5)
+	}
+
+	for _, n := range []int{0, 1, 2, -1, -4, 5, 7, -12} {
+		rotated, physical := build(), build()
+		buffer := &physical.items[0]
+		rotated.Rotate(n)
+		physical.RotateElements(n)
+
+		if &physical.items[0] != buffer {
+			t.Errorf("n=%d: expected the buffer to be reused", n)
+		}
+
+		if !reflect.DeepEqual(physical.ToSlice(), rotated.ToSlice()) {
+			t.Errorf("n=%d: expected %v, got %v", n, rotated.ToSlice(), physical.ToSlice())
+		}
+
+		if physical.front != 0 {
+			t.Errorf("n=%d: expected front index 0, got %d", n, physical.front)
+		}
+
+		ref, _ := physical.FrontRef()
+		if ref != &physical.items[0] {
+			t.Errorf("n=%d: expected FrontRef to point at index 0", n)
+		}
+
+		physical.PushBack(6)
+		if back, _ := physical.Back(); back != 6 || physical.Size() != 6 {
+			t.Errorf("n=%d: expected deque usable after rotation, got %v", n, physical.ToSlice())
+		}
+	}
+
+	// Full buffer wraps rear back to 0
+	full := FromSliceDeque([]int{1, 2, 3, 4})
+	full.RotateElements(1)
+	if !reflect.DeepEqual(full.ToSlice(), []int{4, 1, 2, 3}) || full.rear != 0 {
+		t.Errorf("expected [4 1 2 3] with rear 0, got %v with rear %d", full.ToSlice(), full.rear)
+	}
+
+	empty := NewDeque[int]()
+	empty.RotateElements(3)
+	if !empty.IsEmpty() || empty.front != 0 {
+		t.Error("expected empty deque to stay empty with front 0")
+	}
+}
+
+func TestDequeMapInPlace(t *testing.T) {
+	dq := newWrappedDeque(t, 1, 2, 3, 4)
+	buffer := &dq.items[0]
+
+	dq.MapInPlace(func(v int) int { return v + 1 })
+
+	if !reflect.DeepEqual(dq.ToSlice(), []int{2, 3, 4, 5}) {
+		t.Errorf("expected [2 3 4 5], got %v", dq.ToSlice())
+	}
+
+	if &dq.items[0] != buffer || dq.Capacity() != 8 {
+		t.Error("expected the buffer to be reused")
+	}
+}
+
+func TestDequeForEachUntil(t *testing.T) {
+	// Stopping behaviour is covered by TestQueueForEachUntil; this walks a wrapped buffer
+	dq := newWrappedDeque(t, 1, 2, 3, 4)
+	var visited []int
+
+	dq.ForEachUntil(func(v int) bool {
+		visited = append(visited, v)
+		return v != 3
+	})
+
+	if !reflect.DeepEqual(visited, []int{1, 2, 3}) {
+		t.Errorf("expected visited %v, got %v", []int{1, 2, 3}, visited)
+	}
+}
+
+func TestDequeReverseView(t *testing.T) {
+	dq := DequeOf(1, 2, 3)
+	view := dq.ReverseView()
+
+	if !reflect.DeepEqual(view.ToSlice(), []int{3, 2, 1}) {
+		t.Errorf("expected [3 2 1], got %v", view.ToSlice())
+	}
+
+	if !reflect.DeepEqual(slices.Collect(view.All()), []int{3, 2, 1}) {
+		t.Errorf("expected All to yield [3 2 1], got %v", slices.Collect(view.All()))
+	}
+
+	// The view is live: pushes on the deque show up at the mirrored end
+	dq.PushBack(4)
+	dq.PushFront(0)
+
+	expected := []int{4, 3, 2, 1, 0}
+	if !reflect.DeepEqual(view.ToSlice(), expected) {
+		t.Errorf("expected %v after pushes, got %v", expected, view.ToSlice())
+	}
+
+	for i, want := range expected {
+		if got, err := view.Get(i); err != nil || got != want {
+			t.Errorf("expected Get(%d)=%d, got %d, error=%v", i, want, got, err)
+		}
+	}
+
+	front, _ := view.Front()
+	back, _ := view.Back()
+	if front != 4 || back != 0 || view.Size() != 5 {
+		t.Errorf("expected front 4, back 0, size 5, got %d, %d, %d", front, back, view.Size())
+	}
+
+	// The deque itself is not reordered
+	if !reflect.DeepEqual(dq.ToSlice(), []int{0, 1, 2, 3, 4}) {
+		t.Errorf("expected deque unchanged, got %v", dq.ToSlice())
+	}
+
+	if _, err := view.Get(5); !errors.Is(err, ErrIndexOutOfBounds) {
+		t.Errorf("expected ErrIndexOutOfBounds, got %v", err)
+	}
+
+	dq.Clear()
+	if !view.IsEmpty() {
+		t.Error("expected view of cleared deque to be empty")
+	}
+	if _, err := view.Front(); !errors.Is(err, ErrEmpty) {
+		t.Errorf("expected ErrEmpty, got %v", err)
+	}
+}
+
+func TestDequeCountValue(t *testing.T) {
+	// The full value table lives in TestCountValue; this covers a wrapped buffer
+	dq := newWrappedDeque(t, 3, 1, 2, 1)
+
+	if got := dq.CountValue(1); got != 2 {
+		t.Errorf("expected 2 occurrences of 1, got %d", got)
+	}
+}
+
+func TestFoldDeque(t *testing.T) {
+	dq := newWrappedDeque(t, 'h', 'e', 'l', 'l', 'o')
+
+	appendRune := func(acc string, r rune) string { return acc + string(r) }
+
+	word := FoldDeque(dq, "", appendRune)
+	if word != "hello" {
+		t.Errorf("expected hello, got %q", word)
+	}
+
+	if got := FoldDeque(NewDeque[rune](), "init", appendRune); got != "init" {
+		t.Errorf("expected init for empty deque, got %q", got)
+	}
+}
+
+func TestDequePopN(t *testing.T) {
+	tests := []struct {
+		name          string
+		n             int
+		front         []int
+		frontRemains  []int
+		back          []int
+		backRemains   []int
+		expectedError error
+	}{
+		{"partial", 2, []int{1, 2}, []int{3, 4, 5}, []int{5, 4}, []int{1, 2, 3}, nil},
+		{"exact", 5, []int{1, 2, 3, 4, 5}, []int{}, []int{5, 4, 3, 2, 1}, []int{}, nil},
+		{"zero", 0, []int{}, []int{1, 2, 3, 4, 5}, []int{}, []int{1, 2, 3, 4, 5}, nil},
+		{"over count", 6, nil, []int{1, 2, 3, 4, 5}, nil, []int{1, 2, 3, 4, 5}, ErrInsufficientElements},
+		{"negative", -1, nil, []int{1, 2, 3, 4, 5}, nil, []int{1, 2, 3, 4, 5}, ErrInvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dq := DequeOf(1, 2, 3, 4, 5)
+			got, err := dq.PopFrontN(tt.n)
+			if !errors.Is(err, tt.expectedError) {
+				t.Errorf("PopFrontN: expected error %v, got %v", tt.expectedError, err)
+			}
+			if !reflect.DeepEqual(got, tt.front) || !reflect.DeepEqual(dq.ToSlice(), tt.frontRemains) {
+				t.Errorf("PopFrontN: expected %v leaving %v, got %v leaving %v", tt.front, tt.frontRemains, got, dq.ToSlice())
+			}
+
+			dq = DequeOf(1, 2, 3, 4, 5)
+			got, err = dq.PopBackN(tt.n)
+			if !errors.Is(err, tt.expectedError) {
+				t.Errorf("PopBackN: expected error %v, got %v", tt.expectedError, err)
+			}
+			if !reflect.DeepEqual(got, tt.back) || !reflect.DeepEqual(dq.ToSlice(), tt.backRemains) {
+				t.Errorf("PopBackN: expected %v leaving %v, got %v leaving %v", tt.back, tt.backRemains, got, dq.ToSlice())
+			}
+		})
+	}
+}
+
 // Benchmark tests
 func BenchmarkPushFront(b *testing.B) {
 	dq := NewDeque[int]()
@@ -540,6 +1604,30 @@ func TestPopBack(t *testing.T) {
 	}
 }
 
+func TestTryPopFrontBack(t *testing.T) {
+	dq := FromSliceDeque([]int{1, 2, 3})
+
+	front, ok := dq.TryPopFront()
+	if !ok || front != 1 {
+		t.Errorf("expected front=1, ok=true, got %d, %t", front, ok)
+	}
+
+	back, ok := dq.TryPopBack()
+	if !ok || back != 3 {
+		t.Errorf("expected back=3, ok=true, got %d, %t", back, ok)
+	}
+
+	dq.TryPopFront()
+
+	if value, ok := dq.TryPopFront(); ok || value != 0 {
+		t.Errorf("expected zero value and ok=false from front, got %d, %t", value, ok)
+	}
+
+	if value, ok := dq.TryPopBack(); ok || value != 0 {
+		t.Errorf("expected zero value and ok=false from back, got %d, %t", value, ok)
+	}
+}
+
 func TestMixedPopOperations(t *testing.T) {
 	dq := FromSliceDeque([]int{1, 2, 3, 4, 5})
 
@@ -600,3 +1688,40 @@ func TestFrontBack(t *testing.T) {
 		t.Errorf("expected back=2, got %d", back)
 	}
 }
+
+// bulkLoadSize is the number of elements loaded by the bulk-load benchmarks.
+const bulkLoadSize = 1_000_000
+
+func BenchmarkLoadPushBackLoop(b *testing.B) {
+	values := make([]int, bulkLoadSize)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		dq := NewDeque[int]()
+		for _, v := range values {
+			dq.PushBack(v)
+		}
+	}
+}
+
+func BenchmarkLoadPushBackN(b *testing.B) {
+	values := make([]int, bulkLoadSize)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		dq := NewDeque[int]()
+		dq.PushBackN(values...)
+	}
+}
+
+func BenchmarkLoadFromSliceDeque(b *testing.B) {
+	values := make([]int, bulkLoadSize)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		FromSliceDeque(values)
+	}
+}
@@ -0,0 +1,22 @@
+package collections
+
+import "errors"
+
+// Sentinel errors returned by the collections, wrapped with context about the failing
+// call. Test for them with errors.Is, for example errors.Is(err, ErrEmpty).
+var (
+	// ErrEmpty is returned when reading or removing from an empty collection.
+	ErrEmpty = errors.New("collection is empty")
+	// ErrIndexOutOfBounds is returned when an index, offset or range falls outside the collection.
+	ErrIndexOutOfBounds = errors.New("index out of bounds")
+	// ErrInsufficientElements is returned when asking for more elements than the collection holds.
+	ErrInsufficientElements = errors.New("not enough elements")
+	// ErrInvalidArgument is returned for arguments that are invalid regardless of the contents,
+	// such as negative counts, non-positive sizes or nil functions.
+	ErrInvalidArgument = errors.New("invalid argument")
+	// ErrNotFound is returned when a value that must be present is missing.
+	ErrNotFound = errors.New("value not found")
+	// ErrCycle is returned when an operation cannot proceed because a linked list or graph
+	// contains a cycle.
+	ErrCycle = errors.New("cycle detected")
+)
@@ -0,0 +1,95 @@
+package collections
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestErrEmpty(t *testing.T) {
+	checks := []struct {
+		name string
+		call func() error
+	}{
+		{"Stack.Pop", func() error { _, err := NewStack[int]().Pop(); return err }},
+		{"Stack.Peek", func() error { _, err := NewStack[int]().Peek(); return err }},
+		{"Stack.DupTop", func() error { return NewStack[int]().DupTop() }},
+		{"Queue.Dequeue", func() error { _, err := NewQueue[int]().Dequeue(); return err }},
+		{"Queue.Front", func() error { _, err := NewQueue[int]().Front(); return err }},
+		{"Queue.Rear", func() error { _, err := NewQueue[int]().Rear(); return err }},
+		{"Deque.PopFront", func() error { _, err := NewDeque[int]().PopFront(); return err }},
+		{"Deque.PopBack", func() error { _, err := NewDeque[int]().PopBack(); return err }},
+		{"Deque.FrontRef", func() error { _, err := NewDeque[int]().FrontRef(); return err }},
+		{"LinkedList.Head", func() error { _, err := NewLinkedList[int]().Head(); return err }},
+		{"LinkedList.Tail", func() error { _, err := NewLinkedList[int]().Tail(); return err }},
+		{"PriorityQueue.Pop", func() error { _, err := NewMinPriorityQueue[int]().Pop(); return err }},
+		{"IndexedPriorityQueue.Peek", func() error { _, _, err := NewIndexedPriorityQueue[int]().Peek(); return err }},
+		{"StackQueue.Dequeue", func() error { _, err := NewStackQueue[int]().Dequeue(); return err }},
+		{"QueueStack.Pop", func() error { _, err := NewQueueStack[int]().Pop(); return err }},
+	}
+
+	for _, c := range checks {
+		t.Run(c.name, func(t *testing.T) {
+			if err := c.call(); !errors.Is(err, ErrEmpty) {
+				t.Errorf("expected ErrEmpty, got %v", err)
+			}
+		})
+	}
+}
+
+func TestErrIndexOutOfBounds(t *testing.T) {
+	checks := []struct {
+		name string
+		call func() error
+	}{
+		{"Deque.Get", func() error { _, err := DequeOf(1, 2).Get(2); return err }},
+		{"Deque.Set", func() error { return DequeOf(1, 2).Set(-3, 0) }},
+		{"Deque.Slice", func() error { _, err := DequeOf(1, 2).Slice(1, 3); return err }},
+		{"Queue.PeekBack", func() error { _, err := QueueOf(1, 2).PeekBack(2); return err }},
+		{"LinkedList.Get", func() error { _, err := ListOf(1, 2).Get(5); return err }},
+		{"LinkedList.Insert", func() error { return ListOf(1, 2).Insert(-1, 0) }},
+		{"LinkedList.DeleteAt", func() error { return ListOf(1, 2).DeleteAt(2) }},
+		{"LinkedList.GetNode", func() error { _, err := ListOf(1, 2).GetNode(2); return err }},
+	}
+
+	for _, c := range checks {
+		t.Run(c.name, func(t *testing.T) {
+			if err := c.call(); !errors.Is(err, ErrIndexOutOfBounds) {
+				t.Errorf("expected ErrIndexOutOfBounds, got %v", err)
+			}
+		})
+	}
+}
+
+func TestErrSentinelsOther(t *testing.T) {
+	checks := []struct {
+		name   string
+		target error
+		call   func() error
+	}{
+		{"Stack.MultiPop too many", ErrInsufficientElements, func() error { _, err := StackOf(1).MultiPop(2); return err }},
+		{"Queue.MultiDequeue too many", ErrInsufficientElements, func() error {
+			_, err := QueueOf(1).MultiDequeue(2)
+			return err
+		}},
+		{"Queue.PeekN negative", ErrInvalidArgument, func() error { _, err := QueueOf(1).PeekN(-1); return err }},
+		{"Queue.Chunk zero", ErrInvalidArgument, func() error { _, err := QueueOf(1).Chunk(0); return err }},
+		{"IndexedPriorityQueue.Update missing", ErrNotFound, func() error {
+			return NewIndexedPriorityQueue[int]().Update(1, 1)
+		}},
+	}
+
+	for _, c := range checks {
+		t.Run(c.name, func(t *testing.T) {
+			if err := c.call(); !errors.Is(err, c.target) {
+				t.Errorf("expected %v, got %v", c.target, err)
+			}
+		})
+	}
+
+	ll := ListOf(1, 2)
+	node, _ := ll.GetNode(1)
+	node.Next = node
+	if _, err := ll.CloneSafe(); !errors.Is(err, ErrCycle) {
+		t.Errorf("expected ErrCycle, got %v", err)
+	}
+}
@@ -0,0 +1,126 @@
+package collections
+
+// EvictingDeque represents a double-ended queue with a fixed maximum size.
+// Pushing onto a full deque evicts the element at the opposite end: PushBack drops the
+// front and PushFront drops the back. This makes it a natural fit for fixed-length
+// histories such as "the last n events".
+type EvictingDeque[T any] struct {
+	deque   *Deque[T]
+	maxSize int
+}
+
+// NewEvictingDeque creates and returns a new empty deque holding at most maxSize elements.
+// A maxSize below 1 is treated as 1.
+func NewEvictingDeque[T any](maxSize int) *EvictingDeque[T] {
+	if maxSize < 1 {
+		maxSize = 1
+	}
+	return &EvictingDeque[T]{
+		deque:   NewDequeWithCapacity[T](maxSize),
+		maxSize: maxSize,
+	}
+}
+
+// PushBack adds an element to the back of the deque.
+// If the deque was full, the front element is evicted and returned with true.
+// Time complexity: O(1)
+func (ed *EvictingDeque[T]) PushBack(value T) (T, bool) {
+	var evicted T
+	full := ed.deque.Size() == ed.maxSize
+	if full {
+		evicted, _ = ed.deque.PopFront()
+	}
+
+	ed.deque.PushBack(value)
+	return evicted, full
+}
+
+// PushFront adds an element to the front of the deque.
+// If the deque was full, the back element is evicted and returned with true.
+// Time complexity: O(1)
+func (ed *EvictingDeque[T]) PushFront(value T) (T, bool) {
+	var evicted T
+	full := ed.deque.Size() == ed.maxSize
+	if full {
+		evicted, _ = ed.deque.PopBack()
+	}
+
+	ed.deque.PushFront(value)
+	return evicted, full
+}
+
+// PopFront removes and returns the front element from the deque.
+// Returns an error if the deque is empty.
+// Time complexity: O(1)
+func (ed *EvictingDeque[T]) PopFront() (T, error) {
+	return ed.deque.PopFront()
+}
+
+// PopBack removes and returns the back element from the deque.
+// Returns an error if the deque is empty.
+// Time complexity: O(1)
+func (ed *EvictingDeque[T]) PopBack() (T, error) {
+	return ed.deque.PopBack()
+}
+
+// Front returns the front element without removing it.
+// Returns an error if the deque is empty.
+// Time complexity: O(1)
+func (ed *EvictingDeque[T]) Front() (T, error) {
+	return ed.deque.Front()
+}
+
+// Back returns the back element without removing it.
+// Returns an error if the deque is empty.
+// Time complexity: O(1)
+func (ed *EvictingDeque[T]) Back() (T, error) {
+	return ed.deque.Back()
+}
+
+// Get returns the element at the specified index (0 = front).
+// Negative indices count from the back, so -1 is the back element and -Size() the front.
+// Returns an error if the index is out of bounds.
+// Time complexity: O(1)
+func (ed *EvictingDeque[T]) Get(index int) (T, error) {
+	return ed.deque.Get(index)
+}
+
+// Size returns the number of elements in the deque.
+// Time complexity: O(1)
+func (ed *EvictingDeque[T]) Size() int {
+	return ed.deque.Size()
+}
+
+// MaxSize returns the maximum number of elements the deque holds.
+func (ed *EvictingDeque[T]) MaxSize() int {
+	return ed.maxSize
+}
+
+// IsEmpty returns true if the deque is empty.
+// Time complexity: O(1)
+func (ed *EvictingDeque[T]) IsEmpty() bool {
+	return ed.deque.IsEmpty()
+}
+
+// IsFull returns true if the next push will evict an element.
+// Time complexity: O(1)
+func (ed *EvictingDeque[T]) IsFull() bool {
+	return ed.deque.Size() == ed.maxSize
+}
+
+// Clear removes all elements from the deque.
+// Time complexity: O(1)
+func (ed *EvictingDeque[T]) Clear() {
+	ed.deque.Clear()
+}
+
+// ToSlice returns a copy of the deque as a slice, front first.
+// Time complexity: O(n)
+func (ed *EvictingDeque[T]) ToSlice() []T {
+	return ed.deque.ToSlice()
+}
+
+// String returns a string representation of the deque.
+func (ed *EvictingDeque[T]) String() string {
+	return ed.deque.String()
+}
@@ -0,0 +1,110 @@
+package collections
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestNewEvictingDeque(t *testing.T) {
+	ed := NewEvictingDeque[int](3)
+
+	if ed.Size() != 0 || !ed.IsEmpty() || ed.IsFull() {
+		t.Error("expected empty, non-full deque")
+	}
+
+	if ed.MaxSize() != 3 {
+		t.Errorf("expected max size 3, got %d", ed.MaxSize())
+	}
+
+	if NewEvictingDeque[int](0).MaxSize() != 1 {
+		t.Error("expected non-positive max size to be raised to 1")
+	}
+}
+
+func TestEvictingDequePushBack(t *testing.T) {
+	ed := NewEvictingDeque[int](3)
+
+	for i := 1; i <= 3; i++ {
+		if _, evicted := ed.PushBack(i); evicted {
+			t.Errorf("expected no eviction while filling, pushed %d", i)
+		}
+	}
+
+	tests := []struct {
+		push     int
+		evicted  int
+		expected []int
+	}{
+		{4, 1, []int{2, 3, 4}},
+		{5, 2, []int{3, 4, 5}},
+		{6, 3, []int{4, 5, 6}},
+	}
+
+	for _, tt := range tests {
+		dropped, ok := ed.PushBack(tt.push)
+		if !ok || dropped != tt.evicted {
+			t.Errorf("push %d: expected eviction of %d, got %d (evicted=%v)", tt.push, tt.evicted, dropped, ok)
+		}
+		if !reflect.DeepEqual(ed.ToSlice(), tt.expected) {
+			t.Errorf("push %d: expected %v, got %v", tt.push, tt.expected, ed.ToSlice())
+		}
+	}
+}
+
+func TestEvictingDequePushFront(t *testing.T) {
+	ed := NewEvictingDeque[int](3)
+	ed.PushBack(1)
+	ed.PushBack(2)
+	ed.PushBack(3)
+
+	dropped, ok := ed.PushFront(0)
+	if !ok || dropped != 3 {
+		t.Errorf("expected eviction of 3, got %d (evicted=%v)", dropped, ok)
+	}
+
+	dropped, ok = ed.PushBack(9)
+	if !ok || dropped != 0 {
+		t.Errorf("expected eviction of 0, got %d (evicted=%v)", dropped, ok)
+	}
+
+	expected := []int{1, 2, 9}
+	if !reflect.DeepEqual(ed.ToSlice(), expected) {
+		t.Errorf("expected %v, got %v", expected, ed.ToSlice())
+	}
+
+	// Popping makes room again
+	ed.PopFront()
+	if _, evicted := ed.PushFront(7); evicted {
+		t.Error("expected no eviction after making room")
+	}
+}
+
+func TestEvictingDequeGet(t *testing.T) {
+	ed := NewEvictingDeque[int](3)
+	for _, v := range []int{1, 2, 3, 4} {
+		ed.PushBack(v) // 1 is evicted
+	}
+
+	tests := []struct {
+		index    int
+		expected int
+	}{
+		{0, 2},
+		{2, 4},
+		{-1, 4},
+		{-3, 2},
+	}
+
+	for _, tt := range tests {
+		if value, err := ed.Get(tt.index); err != nil || value != tt.expected {
+			t.Errorf("index %d: expected %d, got %d, error=%v", tt.index, tt.expected, value, err)
+		}
+	}
+
+	for _, index := range []int{3, -4} {
+		if _, err := ed.Get(index); !errors.Is(err, ErrIndexOutOfBounds) {
+			t.Errorf("index %d: expected ErrIndexOutOfBounds, got %v", index, err)
+		}
+	}
+}
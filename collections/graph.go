@@ -0,0 +1,172 @@
+package collections
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Graph represents a directed graph with generic vertex type support.
+// Implemented as an adjacency list. Vertices and each vertex's neighbors are kept in
+// insertion order, so traversals over the graph are deterministic.
+type Graph[T comparable] struct {
+	adjacency map[T][]T
+	vertices  []T // Vertices in insertion order
+	edges     int
+}
+
+// NewGraph creates and returns a new empty directed graph.
+func NewGraph[T comparable]() *Graph[T] {
+	return &Graph[T]{
+		adjacency: make(map[T][]T),
+		vertices:  make([]T, 0),
+	}
+}
+
+// AddVertex adds v to the graph and returns true, or returns false if it was already present.
+// Time complexity: O(1) amortized
+func (g *Graph[T]) AddVertex(v T) bool {
+	if _, ok := g.adjacency[v]; ok {
+		return false
+	}
+
+	g.adjacency[v] = nil
+	g.vertices = append(g.vertices, v)
+	return true
+}
+
+// AddEdge adds a directed edge from one vertex to another, adding either vertex if missing.
+// Returns false if the edge already existed.
+// Time complexity: O(d) where d is the out-degree of from
+func (g *Graph[T]) AddEdge(from, to T) bool {
+	g.AddVertex(from)
+	g.AddVertex(to)
+
+	if slices.Contains(g.adjacency[from], to) {
+		return false
+	}
+
+	g.adjacency[from] = append(g.adjacency[from], to)
+	g.edges++
+	return true
+}
+
+// HasVertex checks if the graph contains v.
+// Time complexity: O(1)
+func (g *Graph[T]) HasVertex(v T) bool {
+	_, ok := g.adjacency[v]
+	return ok
+}
+
+// HasEdge checks if the graph contains a directed edge from one vertex to another.
+// Time complexity: O(d) where d is the out-degree of from
+func (g *Graph[T]) HasEdge(from, to T) bool {
+	return slices.Contains(g.adjacency[from], to)
+}
+
+// Neighbors returns a copy of the vertices v has edges to, in the order the edges were added.
+// Returns an error if v is not in the graph.
+// Time complexity: O(d) where d is the out-degree of v
+func (g *Graph[T]) Neighbors(v T) ([]T, error) {
+	neighbors, ok := g.adjacency[v]
+	if !ok {
+		return nil, fmt.Errorf("%w: vertex %v in graph", ErrNotFound, v)
+	}
+
+	return slices.Clone(neighbors), nil
+}
+
+// Vertices returns a copy of all vertices in insertion order.
+// Time complexity: O(V)
+func (g *Graph[T]) Vertices() []T {
+	return slices.Clone(g.vertices)
+}
+
+// VertexCount returns the number of vertices in the graph.
+// Time complexity: O(1)
+func (g *Graph[T]) VertexCount() int {
+	return len(g.vertices)
+}
+
+// EdgeCount returns the number of edges in the graph.
+// Time complexity: O(1)
+func (g *Graph[T]) EdgeCount() int {
+	return g.edges
+}
+
+// TopoSort returns the vertices of g in topological order: every vertex comes before
+// all vertices it has edges to. It uses Kahn's algorithm, repeatedly taking vertices
+// with no remaining incoming edges from a Queue. Ties are broken by insertion order.
+// Returns an error wrapping ErrCycle if a cycle prevents a complete ordering.
+// Time complexity: O(V + E)
+func TopoSort[T comparable](g *Graph[T]) ([]T, error) {
+	indegree := make(map[T]int, len(g.vertices))
+	for _, v := range g.vertices {
+		for _, to := range g.adjacency[v] {
+			indegree[to]++
+		}
+	}
+
+	ready := NewQueue[T]()
+	for _, v := range g.vertices {
+		if indegree[v] == 0 {
+			ready.Enqueue(v)
+		}
+	}
+
+	order := make([]T, 0, len(g.vertices))
+	for !ready.IsEmpty() {
+		v, _ := ready.Dequeue()
+		order = append(order, v)
+
+		for _, to := range g.adjacency[v] {
+			indegree[to]--
+			if indegree[to] == 0 {
+				ready.Enqueue(to)
+			}
+		}
+	}
+
+	if len(order) < len(g.vertices) {
+		return nil, fmt.Errorf("cannot sort graph: %w among %d vertices", ErrCycle, len(g.vertices)-len(order))
+	}
+
+	return order, nil
+}
+
+// BFSLevels performs a breadth-first search of g from start and returns the reachable
+// vertices grouped by their distance from start: level 0 is start itself, level 1 its
+// neighbors, and so on. Within a level, vertices appear in the order they were discovered.
+// Returns nil if start is not in the graph.
+// Time complexity: O(V + E)
+func BFSLevels[T comparable](g *Graph[T], start T) [][]T {
+	if !g.HasVertex(start) {
+		return nil
+	}
+
+	visited := map[T]bool{start: true}
+	frontier := NewQueue[T]()
+	frontier.Enqueue(start)
+
+	var levels [][]T
+	for !frontier.IsEmpty() {
+		// Everything queued now is at the same distance; drain exactly that many
+		levelSize := frontier.Size()
+		level := make([]T, 0, levelSize)
+
+		for range levelSize {
+			v, _ := frontier.Dequeue()
+			level = append(level, v)
+
+			for _, to := range g.adjacency[v] {
+				if !visited[to] {
+					visited[to] = true
+					frontier.Enqueue(to)
+				}
+			}
+		}
+
+		levels = append(levels, level)
+	}
+
+	return levels
+}
@@ -0,0 +1,128 @@
+package collections
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestNewGraph(t *testing.T) {
+	g := NewGraph[string]()
+
+	if g.VertexCount() != 0 || g.EdgeCount() != 0 {
+		t.Error("expected empty graph")
+	}
+
+	if !g.AddVertex("a") || g.AddVertex("a") {
+		t.Error("expected first AddVertex to succeed and the duplicate to fail")
+	}
+
+	if !g.AddEdge("a", "b") || g.AddEdge("a", "b") {
+		t.Error("expected first AddEdge to succeed and the duplicate to fail")
+	}
+
+	if !g.HasVertex("b") || !g.HasEdge("a", "b") || g.HasEdge("b", "a") {
+		t.Error("unexpected vertex or edge membership")
+	}
+
+	g.AddEdge("a", "c")
+	neighbors, err := g.Neighbors("a")
+	if err != nil || !reflect.DeepEqual(neighbors, []string{"b", "c"}) {
+		t.Errorf("expected neighbors [b c], got %v, error=%v", neighbors, err)
+	}
+
+	if !reflect.DeepEqual(g.Vertices(), []string{"a", "b", "c"}) {
+		t.Errorf("expected vertices [a b c], got %v", g.Vertices())
+	}
+
+	if g.VertexCount() != 3 || g.EdgeCount() != 2 {
+		t.Errorf("expected 3 vertices and 2 edges, got %d and %d", g.VertexCount(), g.EdgeCount())
+	}
+
+	if _, err := g.Neighbors("z"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound for missing vertex, got %v", err)
+	}
+}
+
+func TestTopoSort(t *testing.T) {
+	g := NewGraph[string]()
+	edges := [][2]string{
+		{"shirt", "tie"}, {"tie", "jacket"}, {"pants", "shoes"},
+		{"pants", "belt"}, {"belt", "jacket"}, {"shirt", "belt"}, {"socks", "shoes"},
+	}
+	for _, e := range edges {
+		g.AddEdge(e[0], e[1])
+	}
+	g.AddVertex("watch") // Isolated vertex
+
+	order, err := TopoSort(g)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(order) != g.VertexCount() {
+		t.Fatalf("expected %d vertices, got %v", g.VertexCount(), order)
+	}
+
+	position := make(map[string]int, len(order))
+	for i, v := range order {
+		position[v] = i
+	}
+
+	for _, e := range edges {
+		if position[e[0]] >= position[e[1]] {
+			t.Errorf("expected %s before %s in %v", e[0], e[1], order)
+		}
+	}
+
+	empty, err := TopoSort(NewGraph[int]())
+	if err != nil || len(empty) != 0 {
+		t.Errorf("expected empty order for empty graph, got %v, error=%v", empty, err)
+	}
+}
+
+func TestTopoSortCycle(t *testing.T) {
+	g := NewGraph[int]()
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 4)
+	g.AddEdge(4, 2) // Cycle 2 -> 3 -> 4 -> 2
+
+	order, err := TopoSort(g)
+	if !errors.Is(err, ErrCycle) {
+		t.Errorf("expected ErrCycle, got %v", err)
+	}
+
+	if order != nil {
+		t.Errorf("expected no order, got %v", order)
+	}
+}
+
+func TestBFSLevels(t *testing.T) {
+	g := NewGraph[int]()
+	for _, e := range [][2]int{{1, 2}, {1, 3}, {2, 4}, {3, 4}, {3, 5}, {4, 6}, {6, 1}} {
+		g.AddEdge(e[0], e[1])
+	}
+	g.AddEdge(7, 1) // Reaches the graph but is unreachable from 1
+	g.AddVertex(8)  // Isolated
+
+	tests := []struct {
+		name     string
+		start    int
+		expected [][]int
+	}{
+		{"from root", 1, [][]int{{1}, {2, 3}, {4, 5}, {6}}},
+		{"cycle back to root", 4, [][]int{{4}, {6}, {1}, {2, 3}, {5}}},
+		{"leaf", 5, [][]int{{5}}},
+		{"isolated start", 8, [][]int{{8}}},
+		{"missing start", 99, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := BFSLevels(g, tt.start); !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
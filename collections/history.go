@@ -0,0 +1,81 @@
+package collections
+
+// History models browser-style back/forward navigation with two stacks.
+// The current entry sits between a back stack of earlier entries and a forward stack
+// of entries that were left by going back. Visiting a new entry clears forward history.
+type History[T any] struct {
+	back       *Stack[T] // Earlier entries, most recent on top
+	forward    *Stack[T] // Entries left by Back, next one on top
+	current    T
+	hasCurrent bool
+}
+
+// NewHistory creates and returns a new empty history.
+func NewHistory[T any]() *History[T] {
+	return &History[T]{
+		back:    NewStack[T](),
+		forward: NewStack[T](),
+	}
+}
+
+// Visit makes value the current entry. The previous current entry, if any, becomes
+// reachable with Back, and all forward history is discarded.
+// Time complexity: O(1) amortized
+func (h *History[T]) Visit(value T) {
+	if h.hasCurrent {
+		h.back.Push(h.current)
+	}
+
+	h.current = value
+	h.hasCurrent = true
+	h.forward.Clear()
+}
+
+// Back moves to the previous entry and returns it.
+// Returns the zero value and false if there is no earlier entry.
+// Time complexity: O(1)
+func (h *History[T]) Back() (T, bool) {
+	previous, ok := h.back.TryPop()
+	if !ok {
+		var zero T
+		return zero, false
+	}
+
+	h.forward.Push(h.current)
+	h.current = previous
+	return previous, true
+}
+
+// Forward moves to the next entry left by Back and returns it.
+// Returns the zero value and false if there is no forward history.
+// Time complexity: O(1)
+func (h *History[T]) Forward() (T, bool) {
+	next, ok := h.forward.TryPop()
+	if !ok {
+		var zero T
+		return zero, false
+	}
+
+	h.back.Push(h.current)
+	h.current = next
+	return next, true
+}
+
+// Current returns the current entry.
+// Returns the zero value and false if nothing has been visited yet.
+// Time complexity: O(1)
+func (h *History[T]) Current() (T, bool) {
+	return h.current, h.hasCurrent
+}
+
+// CanGoBack returns true if Back would succeed.
+// Time complexity: O(1)
+func (h *History[T]) CanGoBack() bool {
+	return !h.back.IsEmpty()
+}
+
+// CanGoForward returns true if Forward would succeed.
+// Time complexity: O(1)
+func (h *History[T]) CanGoForward() bool {
+	return !h.forward.IsEmpty()
+}
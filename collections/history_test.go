@@ -0,0 +1,83 @@
+package collections
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewHistory(t *testing.T) {
+	h := NewHistory[string]()
+
+	if _, ok := h.Current(); ok {
+		t.Error("expected no current entry")
+	}
+
+	if _, ok := h.Back(); ok {
+		t.Error("expected Back to fail on empty history")
+	}
+
+	if _, ok := h.Forward(); ok {
+		t.Error("expected Forward to fail on empty history")
+	}
+
+	h.Visit("home")
+	if _, ok := h.Back(); ok || h.CanGoBack() {
+		t.Error("expected no back history after a single visit")
+	}
+}
+
+func TestHistoryNavigation(t *testing.T) {
+	h := NewHistory[string]()
+	for _, page := range []string{"a", "b", "c", "d"} {
+		h.Visit(page)
+	}
+
+	steps := []struct {
+		name     string
+		move     func() (string, bool)
+		expected string
+		ok       bool
+	}{
+		{"back", h.Back, "c", true},
+		{"back again", h.Back, "b", true},
+		{"forward", h.Forward, "c", true},
+	}
+
+	for _, step := range steps {
+		got, ok := step.move()
+		if got != step.expected || ok != step.ok {
+			t.Fatalf("%s: expected %q (ok=%v), got %q (ok=%v)", step.name, step.expected, step.ok, got, ok)
+		}
+	}
+
+	if !h.CanGoForward() {
+		t.Error("expected forward history before visiting")
+	}
+
+	// Visiting truncates the forward history ("d")
+	h.Visit("e")
+	if h.CanGoForward() {
+		t.Error("expected forward history to be cleared")
+	}
+	if _, ok := h.Forward(); ok {
+		t.Error("expected Forward to fail after visiting")
+	}
+
+	var trail []string
+	for {
+		page, ok := h.Back()
+		if !ok {
+			break
+		}
+		trail = append(trail, page)
+	}
+
+	expected := []string{"c", "b", "a"}
+	if !reflect.DeepEqual(trail, expected) {
+		t.Errorf("expected back trail %v, got %v", expected, trail)
+	}
+
+	if current, _ := h.Current(); current != "a" {
+		t.Errorf("expected current a, got %q", current)
+	}
+}
@@ -0,0 +1,66 @@
+package collections
+
+import (
+	"cmp"
+	"fmt"
+	"sync"
+)
+
+// IDPool hands out non-negative integer IDs and recycles released ones.
+// Released IDs are kept in a Deque sorted ascending, so Acquire always returns the
+// lowest available ID, falling back to the next fresh one. IDPool is safe for
+// concurrent use by multiple goroutines.
+type IDPool struct {
+	mu   sync.Mutex
+	free *Deque[int] // Released IDs, sorted ascending
+	next int         // Next never-issued ID
+}
+
+// NewIDPool creates and returns a new pool whose first ID is 0.
+func NewIDPool() *IDPool {
+	return &IDPool{
+		free: NewDeque[int](),
+	}
+}
+
+// Acquire returns the lowest available ID: the smallest released one if any,
+// otherwise the next fresh ID.
+// Time complexity: O(1) amortized
+func (p *IDPool) Acquire() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if id, err := p.free.PopFront(); err == nil {
+		return id
+	}
+
+	id := p.next
+	p.next++
+	return id
+}
+
+// Release returns id to the pool so a later Acquire can reuse it.
+// Returns an error wrapping ErrInvalidArgument if id was never acquired or is already free.
+// Time complexity: O(log r + min(k, r-k)) where r is the number of released IDs
+func (p *IDPool) Release(id int) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if id < 0 || id >= p.next {
+		return fmt.Errorf("%w: id %d was never acquired", ErrInvalidArgument, id)
+	}
+
+	if _, found := BinarySearch(p.free, id, cmp.Compare[int]); found {
+		return fmt.Errorf("%w: id %d is already released", ErrInvalidArgument, id)
+	}
+
+	return p.free.InsertSorted(id, Less[int])
+}
+
+// InUse returns the number of IDs currently acquired and not released.
+// Time complexity: O(1)
+func (p *IDPool) InUse() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.next - p.free.Size()
+}
@@ -0,0 +1,101 @@
+package collections
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestIDPoolAcquireRelease(t *testing.T) {
+	p := NewIDPool()
+
+	for expected := 0; expected < 4; expected++ {
+		if id := p.Acquire(); id != expected {
+			t.Errorf("expected fresh id %d, got %d", expected, id)
+		}
+	}
+
+	if err := p.Release(2); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if id := p.Acquire(); id != 2 {
+		t.Errorf("expected released id 2 to be reused, got %d", id)
+	}
+
+	if id := p.Acquire(); id != 4 {
+		t.Errorf("expected fresh id 4 once nothing is free, got %d", id)
+	}
+
+	if p.InUse() != 5 {
+		t.Errorf("expected 5 ids in use, got %d", p.InUse())
+	}
+}
+
+func TestIDPoolLowestFirst(t *testing.T) {
+	p := NewIDPool()
+	for range 6 {
+		p.Acquire()
+	}
+
+	for _, id := range []int{4, 1, 3} {
+		p.Release(id)
+	}
+
+	for _, expected := range []int{1, 3, 4, 6} {
+		if id := p.Acquire(); id != expected {
+			t.Errorf("expected %d, got %d", expected, id)
+		}
+	}
+}
+
+func TestIDPoolInvalidRelease(t *testing.T) {
+	p := NewIDPool()
+	p.Acquire()
+
+	for _, id := range []int{-1, 1, 10} {
+		if err := p.Release(id); !errors.Is(err, ErrInvalidArgument) {
+			t.Errorf("expected ErrInvalidArgument releasing %d, got %v", id, err)
+		}
+	}
+
+	p.Release(0)
+	if err := p.Release(0); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("expected ErrInvalidArgument on double release, got %v", err)
+	}
+
+	if p.InUse() != 0 {
+		t.Errorf("expected 0 ids in use, got %d", p.InUse())
+	}
+}
+
+func TestIDPoolConcurrent(t *testing.T) {
+	p := NewIDPool()
+	const workers, perWorker = 8, 100
+
+	ids := make(chan int, workers*perWorker)
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range perWorker {
+				ids <- p.Acquire()
+			}
+		}()
+	}
+	wg.Wait()
+	close(ids)
+
+	seen := make(map[int]bool)
+	for id := range ids {
+		if seen[id] {
+			t.Fatalf("id %d handed out twice", id)
+		}
+		seen[id] = true
+	}
+
+	if len(seen) != workers*perWorker {
+		t.Errorf("expected %d distinct ids, got %d", workers*perWorker, len(seen))
+	}
+}
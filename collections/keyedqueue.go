@@ -0,0 +1,81 @@
+package collections
+
+import "fmt"
+
+// keyedEntry pairs a value with its priority and insertion sequence inside a KeyedQueue.
+type keyedEntry[T any] struct {
+	value    T
+	priority int
+	seq      uint64
+}
+
+// KeyedQueue represents a FIFO-stable priority queue: Dequeue returns the element with
+// the highest priority key, and elements with equal keys come out in insertion order.
+// Backed by a PriorityQueue whose entries carry an insertion sequence number as the tiebreak.
+type KeyedQueue[T any] struct {
+	heap     *PriorityQueue[keyedEntry[T]]
+	priority func(T) int
+	nextSeq  uint64
+}
+
+// NewKeyedQueue creates and returns a new empty keyed queue.
+// priority is called once per element, on Enqueue; larger values are dequeued first.
+func NewKeyedQueue[T any](priority func(T) int) *KeyedQueue[T] {
+	return &KeyedQueue[T]{
+		heap: NewPriorityQueue(func(a, b keyedEntry[T]) bool {
+			if a.priority != b.priority {
+				return a.priority > b.priority
+			}
+			return a.seq < b.seq
+		}),
+		priority: priority,
+	}
+}
+
+// Enqueue adds an element, computing its priority key.
+// Time complexity: O(log n)
+func (kq *KeyedQueue[T]) Enqueue(value T) {
+	kq.heap.Push(keyedEntry[T]{value: value, priority: kq.priority(value), seq: kq.nextSeq})
+	kq.nextSeq++
+}
+
+// Dequeue removes and returns the highest-priority element, choosing the earliest
+// enqueued among equal priorities.
+// Returns an error wrapping ErrEmpty if the queue is empty.
+// Time complexity: O(log n)
+func (kq *KeyedQueue[T]) Dequeue() (T, error) {
+	var zero T
+
+	if kq.heap.IsEmpty() {
+		return zero, fmt.Errorf("keyed queue: %w", ErrEmpty)
+	}
+
+	entry, _ := kq.heap.Pop()
+	return entry.value, nil
+}
+
+// Front returns the element Dequeue would return, without removing it.
+// Returns an error wrapping ErrEmpty if the queue is empty.
+// Time complexity: O(1)
+func (kq *KeyedQueue[T]) Front() (T, error) {
+	var zero T
+
+	if kq.heap.IsEmpty() {
+		return zero, fmt.Errorf("keyed queue: %w", ErrEmpty)
+	}
+
+	entry, _ := kq.heap.Peek()
+	return entry.value, nil
+}
+
+// Size returns the number of elements in the queue.
+// Time complexity: O(1)
+func (kq *KeyedQueue[T]) Size() int {
+	return kq.heap.Size()
+}
+
+// IsEmpty returns true if the queue is empty.
+// Time complexity: O(1)
+func (kq *KeyedQueue[T]) IsEmpty() bool {
+	return kq.heap.IsEmpty()
+}
@@ -0,0 +1,78 @@
+package collections
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type keyedTask struct {
+	name     string
+	priority int
+}
+
+func drainKeyedQueue(kq *KeyedQueue[keyedTask]) []string {
+	var names []string
+	for !kq.IsEmpty() {
+		task, _ := kq.Dequeue()
+		names = append(names, task.name)
+	}
+	return names
+}
+
+func TestKeyedQueuePriorityOrder(t *testing.T) {
+	kq := NewKeyedQueue(func(task keyedTask) int { return task.priority })
+
+	for _, task := range []keyedTask{{"low", 1}, {"high", 9}, {"mid", 5}, {"urgent", 10}} {
+		kq.Enqueue(task)
+	}
+
+	if front, err := kq.Front(); err != nil || front.name != "urgent" {
+		t.Errorf("expected urgent at the front, got %v, error=%v", front, err)
+	}
+
+	if kq.Size() != 4 {
+		t.Errorf("expected size 4, got %d", kq.Size())
+	}
+
+	expected := []string{"urgent", "high", "mid", "low"}
+	if result := drainKeyedQueue(kq); !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestKeyedQueueFIFOTiebreak(t *testing.T) {
+	kq := NewKeyedQueue(func(task keyedTask) int { return task.priority })
+
+	tasks := []keyedTask{
+		{"a1", 1}, {"b2", 2}, {"c1", 1}, {"d2", 2}, {"e1", 1}, {"f2", 2}, {"g3", 3},
+	}
+	for _, task := range tasks {
+		kq.Enqueue(task)
+	}
+
+	// Interleave a dequeue and enqueue to check ties still respect arrival order
+	first, _ := kq.Dequeue()
+	kq.Enqueue(keyedTask{"h2", 2})
+
+	if first.name != "g3" {
+		t.Errorf("expected g3 first, got %s", first.name)
+	}
+
+	expected := []string{"b2", "d2", "f2", "h2", "a1", "c1", "e1"}
+	if result := drainKeyedQueue(kq); !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestKeyedQueueEmpty(t *testing.T) {
+	kq := NewKeyedQueue(func(v int) int { return v })
+
+	if _, err := kq.Dequeue(); !errors.Is(err, ErrEmpty) {
+		t.Errorf("expected ErrEmpty from Dequeue, got %v", err)
+	}
+
+	if _, err := kq.Front(); !errors.Is(err, ErrEmpty) {
+		t.Errorf("expected ErrEmpty from Front, got %v", err)
+	}
+}
@@ -0,0 +1,81 @@
+package collections
+
+import (
+	"iter"
+	"maps"
+)
+
+// KeyedSet represents an unordered collection of values that are distinct by a derived key.
+// It suits element types that are not comparable, or whose identity is only part of the
+// value. One representative value is stored per key: the first one added.
+// Implemented using a hash map; iteration order is unspecified.
+type KeyedSet[T any, K comparable] struct {
+	items map[K]T
+	key   func(T) K
+}
+
+// NewKeyedSet creates and returns a new empty keyed set that identifies values by key.
+func NewKeyedSet[T any, K comparable](key func(T) K) *KeyedSet[T, K] {
+	return &KeyedSet[T, K]{
+		items: make(map[K]T),
+		key:   key,
+	}
+}
+
+// Add inserts value and returns true, or returns false if a value with the same key
+// was already present. An existing representative is never replaced.
+// Time complexity: O(1)
+func (ks *KeyedSet[T, K]) Add(value T) bool {
+	k := ks.key(value)
+	if _, ok := ks.items[k]; ok {
+		return false
+	}
+	ks.items[k] = value
+	return true
+}
+
+// Remove deletes the value with the same key as value and returns true if one was present.
+// Time complexity: O(1)
+func (ks *KeyedSet[T, K]) Remove(value T) bool {
+	k := ks.key(value)
+	if _, ok := ks.items[k]; !ok {
+		return false
+	}
+	delete(ks.items, k)
+	return true
+}
+
+// Contains checks if the set contains a value with the same key as value.
+// Time complexity: O(1)
+func (ks *KeyedSet[T, K]) Contains(value T) bool {
+	_, ok := ks.items[ks.key(value)]
+	return ok
+}
+
+// Size returns the number of values in the set.
+// Time complexity: O(1)
+func (ks *KeyedSet[T, K]) Size() int {
+	return len(ks.items)
+}
+
+// IsEmpty returns true if the set is empty.
+// Time complexity: O(1)
+func (ks *KeyedSet[T, K]) IsEmpty() bool {
+	return len(ks.items) == 0
+}
+
+// Values returns the stored representative values as a slice in unspecified order.
+// Time complexity: O(n)
+func (ks *KeyedSet[T, K]) Values() []T {
+	result := make([]T, 0, len(ks.items))
+	for _, v := range ks.items {
+		result = append(result, v)
+	}
+	return result
+}
+
+// All returns an iterator over the stored representative values in unspecified order.
+// The set must not be modified during iteration.
+func (ks *KeyedSet[T, K]) All() iter.Seq[T] {
+	return maps.Values(ks.items)
+}
@@ -0,0 +1,73 @@
+package collections
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+type keyedUser struct {
+	ID    int
+	Name  string
+	Roles []string // Makes the struct non-comparable
+}
+
+func TestKeyedSet(t *testing.T) {
+	ks := NewKeyedSet(func(u keyedUser) int { return u.ID })
+
+	if !ks.IsEmpty() {
+		t.Error("expected new set to be empty")
+	}
+
+	if !ks.Add(keyedUser{ID: 1, Name: "ada", Roles: []string{"admin"}}) {
+		t.Error("expected first add to succeed")
+	}
+
+	// Same key, different fields: collapses into the existing entry
+	if ks.Add(keyedUser{ID: 1, Name: "ada lovelace"}) {
+		t.Error("expected add with a duplicate key to fail")
+	}
+
+	ks.Add(keyedUser{ID: 2, Name: "grace"})
+
+	if ks.Size() != 2 {
+		t.Errorf("expected size 2, got %d", ks.Size())
+	}
+
+	// The first representative is kept
+	values := ks.Values()
+	slices.SortFunc(values, func(a, b keyedUser) int { return a.ID - b.ID })
+	expected := []keyedUser{
+		{ID: 1, Name: "ada", Roles: []string{"admin"}},
+		{ID: 2, Name: "grace"},
+	}
+	if !reflect.DeepEqual(values, expected) {
+		t.Errorf("expected %v, got %v", expected, values)
+	}
+
+	if !ks.Contains(keyedUser{ID: 2}) || ks.Contains(keyedUser{ID: 3}) {
+		t.Error("unexpected membership")
+	}
+
+	if !ks.Remove(keyedUser{ID: 1}) || ks.Remove(keyedUser{ID: 1}) {
+		t.Error("expected first remove to succeed and the second to fail")
+	}
+
+	if ks.Contains(keyedUser{ID: 1}) || ks.Size() != 1 {
+		t.Errorf("expected only ID 2 to remain, got %v", ks.Values())
+	}
+}
+
+func TestKeyedSetAll(t *testing.T) {
+	ks := NewKeyedSet(strings.ToLower)
+	for _, word := range []string{"Go", "go", "GO", "Rust", "rust"} {
+		ks.Add(word)
+	}
+
+	result := slices.Sorted(ks.All())
+	expected := []string{"Go", "Rust"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
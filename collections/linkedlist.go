@@ -2,7 +2,10 @@
 package collections
 
 import (
+	"encoding/json"
 	"fmt"
+	"iter"
+	"slices"
 	"strings"
 )
 
@@ -13,19 +16,20 @@ type Node[T any] struct {
 }
 
 // LinkedList represents a singly linked list with generic type support.
+// A dummy head (sentinel) node precedes the first element so that insertion and
+// deletion never need to special-case an empty list or the first node.
+// The sentinel is stored inline, so a LinkedList must not be copied after first use.
 type LinkedList[T any] struct {
-	head *Node[T]
-	tail *Node[T]
-	size int
+	sentinel Node[T]  // sentinel.Next is the first element
+	tail     *Node[T] // Last element, or the sentinel when the list is empty
+	size     int
 }
 
 // NewLinkedList creates and returns a new empty linked list.
 func NewLinkedList[T any]() *LinkedList[T] {
-	return &LinkedList[T]{
-		head: nil,
-		tail: nil,
-		size: 0,
-	}
+	ll := &LinkedList[T]{}
+	ll.tail = &ll.sentinel
+	return ll
 }
 
 // FromSlice creates a new linked list from a slice.
@@ -37,99 +41,99 @@ func FromSlice[T any](slice []T) *LinkedList[T] {
 	return ll
 }
 
-// Append adds an element to the end of the list.
-// Time complexity: O(1)
-func (ll *LinkedList[T]) Append(value T) {
-	newNode := &Node[T]{Value: value}
+// ListOf creates a new linked list holding values, equivalent to FromSlice(values).
+// The first value becomes the head of the list.
+func ListOf[T any](values ...T) *LinkedList[T] {
+	return FromSlice(values)
+}
 
-	if ll.head == nil {
-		ll.head = newNode
-		ll.tail = newNode
-	} else {
-		ll.tail.Next = newNode
-		ll.tail = newNode
+// FromSeq creates a new linked list holding every element yielded by seq, in order.
+// Time complexity: O(n)
+func FromSeq[T any](seq iter.Seq[T]) *LinkedList[T] {
+	ll := NewLinkedList[T]()
+	for value := range seq {
+		ll.Append(value)
+	}
+	return ll
+}
+
+// lazyInit points the tail at the sentinel for a zero-value list.
+func (ll *LinkedList[T]) lazyInit() {
+	if ll.tail == nil {
+		ll.tail = &ll.sentinel
 	}
+}
 
-	ll.size++
+// nodeBefore returns the node preceding the given index (the sentinel for index 0).
+// The index must be in the range [0, size].
+func (ll *LinkedList[T]) nodeBefore(index int) *Node[T] {
+	prev := &ll.sentinel
+	for i := 0; i < index; i++ {
+		prev = prev.Next
+	}
+	return prev
 }
 
-// Prepend adds an element to the beginning of the list.
-// Time complexity: O(1)
-func (ll *LinkedList[T]) Prepend(value T) {
-	newNode := &Node[T]{Value: value, Next: ll.head}
-	ll.head = newNode
+// insertAfter links a new node holding value directly after prev.
+func (ll *LinkedList[T]) insertAfter(prev *Node[T], value T) {
+	newNode := &Node[T]{Value: value, Next: prev.Next}
+	prev.Next = newNode
 
-	if ll.tail == nil {
+	// Update tail if we inserted after the last node
+	if prev == ll.tail {
 		ll.tail = newNode
 	}
 
 	ll.size++
 }
 
-// Insert adds an element at the specified index.
-// Time complexity: O(n)
-func (ll *LinkedList[T]) Insert(index int, value T) error {
-	if index < 0 || index > ll.size {
-		return fmt.Errorf("index %d out of bounds for list of size %d", index, ll.size)
-	}
+// unlinkAfter removes the node directly after prev, which must exist.
+func (ll *LinkedList[T]) unlinkAfter(prev *Node[T]) {
+	nodeToDelete := prev.Next
+	prev.Next = nodeToDelete.Next
 
-	if index == 0 {
-		ll.Prepend(value)
-		return nil
+	// Update tail if we deleted the last node
+	if nodeToDelete == ll.tail {
+		ll.tail = prev
 	}
 
-	if index == ll.size {
-		ll.Append(value)
-		return nil
-	}
+	ll.size--
+}
 
-	newNode := &Node[T]{Value: value}
-	current := ll.head
+// Append adds an element to the end of the list.
+// Time complexity: O(1)
+func (ll *LinkedList[T]) Append(value T) {
+	ll.lazyInit()
+	ll.insertAfter(ll.tail, value)
+}
 
-	// Navigate to position index-1
-	for i := 0; i < index-1; i++ {
-		current = current.Next
-	}
+// Prepend adds an element to the beginning of the list.
+// Time complexity: O(1)
+func (ll *LinkedList[T]) Prepend(value T) {
+	ll.lazyInit()
+	ll.insertAfter(&ll.sentinel, value)
+}
 
-	newNode.Next = current.Next
-	current.Next = newNode
-	ll.size++
+// Insert adds an element at the specified index.
+// Time complexity: O(n)
+func (ll *LinkedList[T]) Insert(index int, value T) error {
+	if index < 0 || index > ll.size {
+		return fmt.Errorf("%w: index %d for list of size %d", ErrIndexOutOfBounds, index, ll.size)
+	}
 
+	ll.lazyInit()
+	ll.insertAfter(ll.nodeBefore(index), value)
 	return nil
 }
 
 // Delete removes the first occurrence of the specified value.
 // Time complexity: O(n)
 func (ll *LinkedList[T]) Delete(value T) bool {
-	if ll.head == nil {
-		return false
-	}
-
-	// Handle deletion of head node
-	if isEqual(ll.head.Value, value) {
-		ll.head = ll.head.Next
-		if ll.head == nil {
-			ll.tail = nil
-		}
-		ll.size--
-		return true
-	}
-
-	current := ll.head
-	for current.Next != nil {
-		if isEqual(current.Next.Value, value) {
-			nodeToDelete := current.Next
-			current.Next = nodeToDelete.Next
-
-			// Update tail if we deleted the last node
-			if nodeToDelete == ll.tail {
-				ll.tail = current
-			}
-
-			ll.size--
+	for prev := &ll.sentinel; prev.Next != nil; prev = prev.Next {
+		if isEqual(prev.Next.Value, value) {
+			ll.unlinkAfter(prev)
 			return true
 		}
-		current = current.Next
 	}
 
 	return false
@@ -139,34 +143,37 @@ func (ll *LinkedList[T]) Delete(value T) bool {
 // Time complexity: O(n)
 func (ll *LinkedList[T]) DeleteAt(index int) error {
 	if index < 0 || index >= ll.size {
-		return fmt.Errorf("index %d out of bounds for list of size %d", index, ll.size)
+		return fmt.Errorf("%w: index %d for list of size %d", ErrIndexOutOfBounds, index, ll.size)
 	}
 
-	// Handle deletion of head node
-	if index == 0 {
-		ll.head = ll.head.Next
-		if ll.head == nil {
-			ll.tail = nil
-		}
-		ll.size--
-		return nil
-	}
+	ll.unlinkAfter(ll.nodeBefore(index))
+	return nil
+}
 
-	current := ll.head
-	// Navigate to position index-1
-	for i := 0; i < index-1; i++ {
-		current = current.Next
+// DeleteAtIndices removes the elements at all the specified indices in a single pass.
+// Indices may be given in any order, and duplicates are removed once. Every index is
+// validated before the list is modified, so on error the list is left unchanged.
+// Time complexity: O(n + k log k) where k is the number of indices
+func (ll *LinkedList[T]) DeleteAtIndices(indices ...int) error {
+	for _, index := range indices {
+		if index < 0 || index >= ll.size {
+			return fmt.Errorf("%w: index %d for list of size %d", ErrIndexOutOfBounds, index, ll.size)
+		}
 	}
 
-	nodeToDelete := current.Next
-	current.Next = nodeToDelete.Next
+	sorted := slices.Compact(slices.Sorted(slices.Values(indices)))
 
-	// Update tail if we deleted the last node
-	if nodeToDelete == ll.tail {
-		ll.tail = current
+	// Walk once, tracking the index of prev.Next so each removal keeps prev in place
+	prev := &ll.sentinel
+	index := 0
+	for _, target := range sorted {
+		for ; index < target; index++ {
+			prev = prev.Next
+		}
+		ll.unlinkAfter(prev)
+		index++
 	}
 
-	ll.size--
 	return nil
 }
 
@@ -176,22 +183,17 @@ func (ll *LinkedList[T]) Get(index int) (T, error) {
 	var zero T
 
 	if index < 0 || index >= ll.size {
-		return zero, fmt.Errorf("index %d out of bounds for list of size %d", index, ll.size)
-	}
-
-	current := ll.head
-	for i := 0; i < index; i++ {
-		current = current.Next
+		return zero, fmt.Errorf("%w: index %d for list of size %d", ErrIndexOutOfBounds, index, ll.size)
 	}
 
-	return current.Value, nil
+	return ll.nodeBefore(index).Next.Value, nil
 }
 
 // Find returns the index of the first occurrence of the specified value.
 // Returns -1 if not found.
 // Time complexity: O(n)
 func (ll *LinkedList[T]) Find(value T) int {
-	current := ll.head
+	current := ll.sentinel.Next
 	index := 0
 
 	for current != nil {
@@ -211,6 +213,18 @@ func (ll *LinkedList[T]) Contains(value T) bool {
 	return ll.Find(value) != -1
 }
 
+// CountValue returns the number of elements equal to value, compared the same way as Contains.
+// Time complexity: O(n)
+func (ll *LinkedList[T]) CountValue(value T) int {
+	count := 0
+	for current := ll.sentinel.Next; current != nil; current = current.Next {
+		if isEqual(current.Value, value) {
+			count++
+		}
+	}
+	return count
+}
+
 // Size returns the number of elements in the list.
 // Time complexity: O(1)
 func (ll *LinkedList[T]) Size() int {
@@ -226,8 +240,8 @@ func (ll *LinkedList[T]) IsEmpty() bool {
 // Clear removes all elements from the list.
 // Time complexity: O(1)
 func (ll *LinkedList[T]) Clear() {
-	ll.head = nil
-	ll.tail = nil
+	ll.sentinel.Next = nil
+	ll.tail = &ll.sentinel
 	ll.size = 0
 }
 
@@ -236,11 +250,11 @@ func (ll *LinkedList[T]) Clear() {
 func (ll *LinkedList[T]) Head() (T, error) {
 	var zero T
 
-	if ll.head == nil {
-		return zero, fmt.Errorf("list is empty")
+	if ll.size == 0 {
+		return zero, fmt.Errorf("list: %w", ErrEmpty)
 	}
 
-	return ll.head.Value, nil
+	return ll.sentinel.Next.Value, nil
 }
 
 // Tail returns the last element without removing it.
@@ -248,8 +262,8 @@ func (ll *LinkedList[T]) Head() (T, error) {
 func (ll *LinkedList[T]) Tail() (T, error) {
 	var zero T
 
-	if ll.tail == nil {
-		return zero, fmt.Errorf("list is empty")
+	if ll.size == 0 {
+		return zero, fmt.Errorf("list: %w", ErrEmpty)
 	}
 
 	return ll.tail.Value, nil
@@ -259,7 +273,7 @@ func (ll *LinkedList[T]) Tail() (T, error) {
 // Time complexity: O(n)
 func (ll *LinkedList[T]) ToSlice() []T {
 	result := make([]T, 0, ll.size)
-	current := ll.head
+	current := ll.sentinel.Next
 
 	for current != nil {
 		result = append(result, current.Value)
@@ -269,16 +283,28 @@ func (ll *LinkedList[T]) ToSlice() []T {
 	return result
 }
 
+// All returns an iterator over the elements from head to tail.
+// The list must not be structurally modified during iteration.
+func (ll *LinkedList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for current := ll.sentinel.Next; current != nil; current = current.Next {
+			if !yield(current.Value) {
+				return
+			}
+		}
+	}
+}
+
 // Reverse reverses the linked list in place.
 // Time complexity: O(n)
 func (ll *LinkedList[T]) Reverse() {
-	if ll.head == nil || ll.head.Next == nil {
+	if ll.size <= 1 {
 		return
 	}
 
 	var prev *Node[T]
-	current := ll.head
-	ll.tail = ll.head // The current head will become the tail
+	current := ll.sentinel.Next
+	ll.tail = current // The current head will become the tail
 
 	for current != nil {
 		next := current.Next
@@ -287,11 +313,22 @@ func (ll *LinkedList[T]) Reverse() {
 		current = next
 	}
 
-	ll.head = prev
+	ll.sentinel.Next = prev
 }
 
 // String returns a string representation of the linked list.
 func (ll *LinkedList[T]) String() string {
+	return ll.format("%v")
+}
+
+// GoString returns a string representation of the linked list with Go-syntax quoted elements,
+// so string elements containing separators remain unambiguous. Implements fmt.GoStringer.
+func (ll *LinkedList[T]) GoString() string {
+	return ll.format("%#v")
+}
+
+// format renders the list from head to tail using verb for each element.
+func (ll *LinkedList[T]) format(verb string) string {
 	if ll.size == 0 {
 		return "[]"
 	}
@@ -299,9 +336,9 @@ func (ll *LinkedList[T]) String() string {
 	var sb strings.Builder
 	sb.WriteString("[")
 
-	current := ll.head
+	current := ll.sentinel.Next
 	for current != nil {
-		sb.WriteString(fmt.Sprintf("%v", current.Value))
+		sb.WriteString(fmt.Sprintf(verb, current.Value))
 		if current.Next != nil {
 			sb.WriteString(" -> ")
 		}
@@ -312,19 +349,288 @@ func (ll *LinkedList[T]) String() string {
 	return sb.String()
 }
 
+// MarshalJSON encodes the list as a JSON array in head-to-tail order.
+// An empty list encodes as []. The value receiver lets lists held by value, such as
+// struct fields, encode as arrays even when they are not addressable.
+func (ll LinkedList[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(ll.ToSlice())
+}
+
+// UnmarshalJSON replaces the contents of the list with the elements of a JSON array,
+// the first array element becoming the head. On error the list is left unchanged.
+func (ll *LinkedList[T]) UnmarshalJSON(data []byte) error {
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+
+	ll.Clear()
+	for _, v := range values {
+		ll.Append(v)
+	}
+	return nil
+}
+
 // GetNode returns the node at the specified index (useful for advanced operations).
 // Time complexity: O(n)
 func (ll *LinkedList[T]) GetNode(index int) (*Node[T], error) {
 	if index < 0 || index >= ll.size {
-		return nil, fmt.Errorf("index %d out of bounds for list of size %d", index, ll.size)
+		return nil, fmt.Errorf("%w: index %d for list of size %d", ErrIndexOutOfBounds, index, ll.size)
 	}
 
-	current := ll.head
-	for i := 0; i < index; i++ {
-		current = current.Next
+	return ll.nodeBefore(index).Next, nil
+}
+
+// HeadNode returns the first node, or nil if the list is empty.
+// Useful for starting a manual traversal without GetNode's O(n) scan.
+// Modifying Values is safe, but changing Next pointers bypasses the list's
+// bookkeeping and can corrupt its size and tail.
+// Time complexity: O(1)
+func (ll *LinkedList[T]) HeadNode() *Node[T] {
+	return ll.sentinel.Next
+}
+
+// TailNode returns the last node, or nil if the list is empty.
+// Changing its Next pointer bypasses the list's bookkeeping and can corrupt the list.
+// Time complexity: O(1)
+func (ll *LinkedList[T]) TailNode() *Node[T] {
+	if ll.size == 0 {
+		return nil
+	}
+	return ll.tail
+}
+
+// ForEachNode calls f for each node from head to tail, allowing in-place edits of Value.
+// Changing a node's Next during iteration is unsafe: the walk follows Next after f
+// returns, and the list's size and tail are not updated.
+// Time complexity: O(n)
+func (ll *LinkedList[T]) ForEachNode(f func(*Node[T])) {
+	for current := ll.sentinel.Next; current != nil; current = current.Next {
+		f(current)
+	}
+}
+
+// ForEachReverse calls f on each element from tail to head without modifying the list.
+// Because the list is singly linked, the values are first copied into a temporary
+// slice, which costs O(n) extra space but avoids the stack depth of a recursive walk.
+// Time complexity: O(n)
+func (ll *LinkedList[T]) ForEachReverse(f func(T)) {
+	values := ll.ToSlice()
+	for i := len(values) - 1; i >= 0; i-- {
+		f(values[i])
+	}
+}
+
+// HasCycle reports whether following Next pointers from the head ever revisits a node.
+// A list built only through its methods never has a cycle; one can be introduced by
+// relinking nodes obtained from GetNode. Uses Floyd's tortoise and hare algorithm.
+// Time complexity: O(n), Space complexity: O(1)
+func (ll *LinkedList[T]) HasCycle() bool {
+	slow := ll.sentinel.Next
+	fast := ll.sentinel.Next
+
+	for fast != nil && fast.Next != nil {
+		slow = slow.Next
+		fast = fast.Next.Next
+		if slow == fast {
+			return true
+		}
 	}
 
-	return current, nil
+	return false
+}
+
+// Clone creates a copy of the list with new nodes holding the same values.
+// Values are copied by assignment, so pointer values share their pointees with the
+// original; use CloneWith to copy them deeply. The list is assumed to be acyclic;
+// use CloneSafe if nodes may have been relinked.
+// Time complexity: O(n)
+func (ll *LinkedList[T]) Clone() *LinkedList[T] {
+	clone := NewLinkedList[T]()
+
+	for current := ll.sentinel.Next; current != nil; current = current.Next {
+		clone.Append(current.Value)
+	}
+
+	return clone
+}
+
+// CloneWith creates a copy of the list whose values are produced by copyElem,
+// preserving order. Like Clone, the list is assumed to be acyclic.
+// Time complexity: O(n) calls to copyElem
+func (ll *LinkedList[T]) CloneWith(copyElem func(T) T) *LinkedList[T] {
+	clone := NewLinkedList[T]()
+
+	for current := ll.sentinel.Next; current != nil; current = current.Next {
+		clone.Append(copyElem(current.Value))
+	}
+
+	return clone
+}
+
+// CloneSafe creates a copy of the list like Clone, but first checks for a cycle.
+// Returns an error instead of looping forever if the list contains a cycle.
+// Time complexity: O(n)
+func (ll *LinkedList[T]) CloneSafe() (*LinkedList[T], error) {
+	if ll.HasCycle() {
+		return nil, fmt.Errorf("cannot clone list: %w", ErrCycle)
+	}
+
+	return ll.Clone(), nil
+}
+
+// RetainIf removes every element for which pred returns false, keeping the rest in order.
+// The list is modified in place and the number of removed elements is returned.
+// Time complexity: O(n)
+func (ll *LinkedList[T]) RetainIf(pred func(T) bool) int {
+	removed := 0
+
+	for prev := &ll.sentinel; prev.Next != nil; {
+		if pred(prev.Next.Value) {
+			prev = prev.Next
+		} else {
+			ll.unlinkAfter(prev)
+			removed++
+		}
+	}
+
+	return removed
+}
+
+// IsSorted reports whether the list is in non-decreasing order according to less,
+// that is, no element is less than the one before it. Empty and single-element lists
+// are sorted.
+// Time complexity: O(n)
+func (ll *LinkedList[T]) IsSorted(less func(a, b T) bool) bool {
+	if ll.sentinel.Next == nil {
+		return true
+	}
+
+	for current := ll.sentinel.Next; current.Next != nil; current = current.Next {
+		if less(current.Next.Value, current.Value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// SpliceInto detaches the nodes in the index range [start, end) from ll and appends
+// them to the end of dst, keeping their order. The nodes themselves are moved, not
+// copied. Returns an error if the range is out of bounds or dst is ll itself.
+// Time complexity: O(end) to find the range boundaries, O(1) to move it
+func (ll *LinkedList[T]) SpliceInto(dst *LinkedList[T], start, end int) error {
+	if start < 0 || end > ll.size || start > end {
+		return fmt.Errorf("%w: range [%d, %d) for list of size %d", ErrIndexOutOfBounds, start, end, ll.size)
+	}
+
+	if dst == ll {
+		return fmt.Errorf("%w: cannot splice a list into itself", ErrInvalidArgument)
+	}
+
+	n := end - start
+	if n == 0 {
+		return nil
+	}
+
+	prev := ll.nodeBefore(start)
+	last := prev
+	for i := 0; i < n; i++ {
+		last = last.Next
+	}
+
+	// Unlink [start, end) from the source
+	first := prev.Next
+	prev.Next = last.Next
+	if last == ll.tail {
+		ll.tail = prev
+	}
+	ll.size -= n
+
+	// Link it after the destination's tail
+	dst.lazyInit()
+	dst.tail.Next = first
+	last.Next = nil
+	dst.tail = last
+	dst.size += n
+
+	return nil
+}
+
+// Flatten concatenates the slices stored in ll into a single new list, preserving order.
+// Empty inner slices contribute nothing.
+// Time complexity: O(n) where n is the total number of inner elements
+func Flatten[T any](ll *LinkedList[[]T]) *LinkedList[T] {
+	result := NewLinkedList[T]()
+
+	for current := ll.sentinel.Next; current != nil; current = current.Next {
+		for _, v := range current.Value {
+			result.Append(v)
+		}
+	}
+
+	return result
+}
+
+// MapList builds a new list by applying f to each element of ll in order.
+// The original list is not modified.
+// Time complexity: O(n)
+func MapList[T, R any](ll *LinkedList[T], f func(T) R) *LinkedList[R] {
+	result := NewLinkedList[R]()
+
+	for current := ll.sentinel.Next; current != nil; current = current.Next {
+		result.Append(f(current.Value))
+	}
+
+	return result
+}
+
+// Zip builds a new list pairing the elements of a and b by position.
+// The result is as long as the shorter input; extra elements are ignored.
+// Neither input is modified.
+// Time complexity: O(min(n, m))
+func Zip[A, B any](a *LinkedList[A], b *LinkedList[B]) *LinkedList[Pair[A, B]] {
+	result := NewLinkedList[Pair[A, B]]()
+
+	for ca, cb := a.sentinel.Next, b.sentinel.Next; ca != nil && cb != nil; ca, cb = ca.Next, cb.Next {
+		result.Append(Pair[A, B]{First: ca.Value, Second: cb.Value})
+	}
+
+	return result
+}
+
+// IntersectionNode returns the first node shared by a and b, where two lists whose nodes
+// were relinked merge into a common tail. Nodes are compared by identity, not by value.
+// Lengths are measured by walking each chain, so the result stays correct even when
+// relinking has left Size out of date. Both chains are assumed to be acyclic.
+// Returns nil and false if the lists do not intersect.
+// Time complexity: O(n + m)
+func IntersectionNode[T any](a, b *LinkedList[T]) (*Node[T], bool) {
+	pa, pb := a.sentinel.Next, b.sentinel.Next
+	lenA, lenB := chainLength(pa), chainLength(pb)
+
+	// Advance the longer chain so both pointers are the same distance from the end
+	for ; lenA > lenB; lenA-- {
+		pa = pa.Next
+	}
+	for ; lenB > lenA; lenB-- {
+		pb = pb.Next
+	}
+
+	for pa != pb {
+		pa, pb = pa.Next, pb.Next
+	}
+
+	return pa, pa != nil
+}
+
+// chainLength counts the nodes reachable from node by following Next.
+func chainLength[T any](node *Node[T]) int {
+	n := 0
+	for ; node != nil; node = node.Next {
+		n++
+	}
+	return n
 }
 
 // isEqual compares two values for equality using fmt.Sprintf for comparison.
@@ -1,7 +1,11 @@
 package collections
 
 import (
+	"encoding/json"
+	"errors"
 	"reflect"
+	"slices"
+	"strings"
 	"testing"
 )
 
@@ -16,12 +20,12 @@ func TestNewLinkedList(t *testing.T) {
 		t.Error("expected empty list")
 	}
 
-	if ll.head != nil {
+	if ll.sentinel.Next != nil {
 		t.Error("expected nil head")
 	}
 
-	if ll.tail != nil {
-		t.Error("expected nil tail")
+	if ll.tail != &ll.sentinel {
+		t.Error("expected tail to be the sentinel")
 	}
 }
 
@@ -48,6 +52,48 @@ func TestFromSlice(t *testing.T) {
 	}
 }
 
+func TestListOf(t *testing.T) {
+	if got := ListOf(1, 2, 3).ToSlice(); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("expected [1 2 3], got %v", got)
+	}
+
+	if empty := ListOf[int](); !empty.IsEmpty() {
+		t.Errorf("expected empty collection, got size %d", empty.Size())
+	}
+
+	// Spreading a slice must not alias it
+	values := []int{1, 2}
+	c := ListOf(values...)
+	values[0] = 100
+	if got := c.ToSlice(); !reflect.DeepEqual(got, []int{1, 2}) {
+		t.Errorf("expected [1 2] after modifying source slice, got %v", got)
+	}
+}
+
+func TestFromSeq(t *testing.T) {
+	c := FromSeq(slices.Values([]int{1, 2, 3}))
+
+	if expected := []int{1, 2, 3}; !reflect.DeepEqual(c.ToSlice(), expected) {
+		t.Errorf("expected %v, got %v", expected, c.ToSlice())
+	}
+
+	empty := FromSeq(slices.Values([]int{}))
+	if !empty.IsEmpty() {
+		t.Errorf("expected empty, got size %d", empty.Size())
+	}
+
+	squares := func(yield func(int) bool) {
+		for i := 1; i <= 4; i++ {
+			if !yield(i * i) {
+				return
+			}
+		}
+	}
+	if expected := []int{1, 4, 9, 16}; !reflect.DeepEqual(FromSeq(squares).ToSlice(), expected) {
+		t.Errorf("expected %v from generator, got %v", expected, FromSeq(squares).ToSlice())
+	}
+}
+
 func TestAppend(t *testing.T) {
 	ll := NewLinkedList[int]()
 
@@ -216,6 +262,63 @@ func TestDeleteAt(t *testing.T) {
 	}
 }
 
+func TestDeleteAtIndices(t *testing.T) {
+	tests := []struct {
+		name     string
+		initial  []int
+		indices  []int
+		expected []int
+	}{
+		{"scattered", []int{0, 1, 2, 3, 4, 5, 6}, []int{5, 1, 3}, []int{0, 2, 4, 6}},
+		{"head and tail", []int{1, 2, 3, 4}, []int{0, 3}, []int{2, 3}},
+		{"adjacent", []int{1, 2, 3, 4, 5}, []int{1, 2, 3}, []int{1, 5}},
+		{"duplicates", []int{1, 2, 3}, []int{1, 1, 1}, []int{1, 3}},
+		{"all", []int{1, 2, 3}, []int{2, 0, 1}, []int{}},
+		{"none", []int{1, 2, 3}, nil, []int{1, 2, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ll := FromSlice(tt.initial)
+			if err := ll.DeleteAtIndices(tt.indices...); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if result := ll.ToSlice(); !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+
+			if ll.Size() != len(tt.expected) {
+				t.Errorf("expected size %d, got %d", len(tt.expected), ll.Size())
+			}
+		})
+	}
+
+	t.Run("tail updated", func(t *testing.T) {
+		ll := FromSlice([]int{1, 2, 3, 4})
+		ll.DeleteAtIndices(2, 3)
+		ll.Append(9)
+
+		if expected := []int{1, 2, 9}; !reflect.DeepEqual(ll.ToSlice(), expected) {
+			t.Errorf("expected %v, got %v", expected, ll.ToSlice())
+		}
+	})
+
+	t.Run("out of range aborts", func(t *testing.T) {
+		for _, indices := range [][]int{{0, 5}, {1, -1}} {
+			ll := FromSlice([]int{1, 2, 3})
+
+			if err := ll.DeleteAtIndices(indices...); !errors.Is(err, ErrIndexOutOfBounds) {
+				t.Errorf("expected ErrIndexOutOfBounds for %v, got %v", indices, err)
+			}
+
+			if expected := []int{1, 2, 3}; !reflect.DeepEqual(ll.ToSlice(), expected) {
+				t.Errorf("expected unchanged list %v, got %v", expected, ll.ToSlice())
+			}
+		}
+	})
+}
+
 func TestGet(t *testing.T) {
 	ll := FromSlice([]int{10, 20, 30})
 
@@ -370,8 +473,8 @@ func TestClear(t *testing.T) {
 		t.Error("expected empty list")
 	}
 
-	if ll.head != nil || ll.tail != nil {
-		t.Error("expected nil head and tail")
+	if ll.sentinel.Next != nil || ll.tail != &ll.sentinel {
+		t.Error("expected nil head and tail reset to the sentinel")
 	}
 }
 
@@ -398,6 +501,709 @@ func TestString(t *testing.T) {
 	}
 }
 
+func TestRetainIf(t *testing.T) {
+	isEven := func(v int) bool { return v%2 == 0 }
+
+	tests := []struct {
+		name            string
+		initial         []int
+		pred            func(int) bool
+		expected        []int
+		expectedRemoved int
+	}{
+		{"retain evens", []int{1, 2, 3, 4, 5, 6}, isEven, []int{2, 4, 6}, 3},
+		{"retain all", []int{2, 4, 6}, isEven, []int{2, 4, 6}, 0},
+		{"retain none", []int{1, 3, 5}, isEven, []int{}, 3},
+		{"retain from head", []int{2, 1, 3, 5}, isEven, []int{2}, 3},
+		{"empty list", []int{}, isEven, []int{}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ll := FromSlice(tt.initial)
+			removed := ll.RetainIf(tt.pred)
+
+			if removed != tt.expectedRemoved {
+				t.Errorf("expected %d removed, got %d", tt.expectedRemoved, removed)
+			}
+
+			result := ll.ToSlice()
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+
+			if ll.Size() != len(tt.expected) {
+				t.Errorf("expected size %d, got %d", len(tt.expected), ll.Size())
+			}
+
+			// Tail must point at the last retained element
+			tail, err := ll.Tail()
+			if len(tt.expected) == 0 {
+				if err == nil {
+					t.Error("expected error for tail of empty list")
+				}
+				return
+			}
+			if err != nil || tail != tt.expected[len(tt.expected)-1] {
+				t.Errorf("expected tail %d, got %d, error=%v", tt.expected[len(tt.expected)-1], tail, err)
+			}
+
+			// Appending after RetainIf must link onto the new tail
+			ll.Append(100)
+			if last, _ := ll.Get(ll.Size() - 1); last != 100 {
+				t.Errorf("expected appended value 100 at end, got %d", last)
+			}
+		})
+	}
+}
+
+func TestIsSorted(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+
+	tests := []struct {
+		name     string
+		initial  []int
+		expected bool
+	}{
+		{"sorted", []int{1, 2, 3, 4}, true},
+		{"reverse sorted", []int{4, 3, 2, 1}, false},
+		{"single element", []int{7}, true},
+		{"empty", []int{}, true},
+		{"equal runs", []int{1, 1, 2, 2, 2, 3}, true},
+		{"all equal", []int{5, 5, 5}, true},
+		{"unsorted at end", []int{1, 2, 3, 0}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := FromSlice(tt.initial).IsSorted(less); result != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+
+	var zero LinkedList[int]
+	if !zero.IsSorted(less) {
+		t.Error("expected zero-value list to be sorted")
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	tests := []struct {
+		name     string
+		groups   [][]int
+		expected []int
+	}{
+		{"mixed groups", [][]int{{1, 2}, {}, {3}}, []int{1, 2, 3}},
+		{"all empty", [][]int{{}, {}, nil}, []int{}},
+		{"empty outer list", [][]int{}, []int{}},
+		{"single group", [][]int{{4, 5, 6}}, []int{4, 5, 6}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flat := Flatten(FromSlice(tt.groups))
+			result := flat.ToSlice()
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+
+			if flat.Size() != len(tt.expected) {
+				t.Errorf("expected size %d, got %d", len(tt.expected), flat.Size())
+			}
+		})
+	}
+}
+
+func TestSentinelSingleElement(t *testing.T) {
+	tests := []struct {
+		name   string
+		remove func(ll *LinkedList[int]) bool
+	}{
+		{"delete by value", func(ll *LinkedList[int]) bool { return ll.Delete(7) }},
+		{"delete at index", func(ll *LinkedList[int]) bool { return ll.DeleteAt(0) == nil }},
+		{"retain none", func(ll *LinkedList[int]) bool { return ll.RetainIf(func(int) bool { return false }) == 1 }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ll := FromSlice([]int{7})
+
+			if !tt.remove(ll) {
+				t.Fatal("expected removal to succeed")
+			}
+
+			if !ll.IsEmpty() {
+				t.Errorf("expected empty list, got %v", ll.ToSlice())
+			}
+
+			if _, err := ll.Head(); err == nil {
+				t.Error("expected error for head of empty list")
+			}
+
+			if _, err := ll.Tail(); err == nil {
+				t.Error("expected error for tail of empty list")
+			}
+
+			// The list must be fully reusable after becoming empty
+			ll.Append(1)
+			ll.Prepend(0)
+			ll.Append(2)
+
+			expected := []int{0, 1, 2}
+			if !reflect.DeepEqual(ll.ToSlice(), expected) {
+				t.Errorf("expected %v, got %v", expected, ll.ToSlice())
+			}
+
+			tail, _ := ll.Tail()
+			if tail != 2 {
+				t.Errorf("expected tail=2, got %d", tail)
+			}
+		})
+	}
+}
+
+func TestSentinelHeadInsertRemove(t *testing.T) {
+	ll := NewLinkedList[int]()
+
+	// Repeated insertion at the head of an empty and non-empty list
+	for i := 0; i < 3; i++ {
+		if err := ll.Insert(0, i); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	expected := []int{2, 1, 0}
+	if !reflect.DeepEqual(ll.ToSlice(), expected) {
+		t.Errorf("expected %v, got %v", expected, ll.ToSlice())
+	}
+
+	tail, _ := ll.Tail()
+	if tail != 0 {
+		t.Errorf("expected tail=0 after head inserts, got %d", tail)
+	}
+
+	// Repeated removal from the head until empty
+	for _, want := range []int{2, 1, 0} {
+		head, _ := ll.Head()
+		if head != want {
+			t.Errorf("expected head=%d, got %d", want, head)
+		}
+		if err := ll.DeleteAt(0); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if ll.Size() != 0 || ll.tail != &ll.sentinel {
+		t.Error("expected empty list with tail reset to the sentinel")
+	}
+}
+
+func TestZeroValueLinkedList(t *testing.T) {
+	var ll LinkedList[int]
+
+	if !ll.IsEmpty() {
+		t.Error("expected zero-value list to be empty")
+	}
+
+	if ll.Delete(1) {
+		t.Error("expected delete on zero-value list to fail")
+	}
+
+	ll.Append(2)
+	ll.Prepend(1)
+
+	expected := []int{1, 2}
+	if !reflect.DeepEqual(ll.ToSlice(), expected) {
+		t.Errorf("expected %v, got %v", expected, ll.ToSlice())
+	}
+}
+
+func TestHasCycle(t *testing.T) {
+	ll := FromSlice([]int{1, 2, 3, 4})
+	if ll.HasCycle() {
+		t.Error("expected no cycle in a regular list")
+	}
+
+	if NewLinkedList[int]().HasCycle() {
+		t.Error("expected no cycle in an empty list")
+	}
+
+	// Wire the tail back to the second node
+	second, _ := ll.GetNode(1)
+	last, _ := ll.GetNode(3)
+	last.Next = second
+
+	if !ll.HasCycle() {
+		t.Error("expected cycle to be detected")
+	}
+
+	// Self-loop on a single node
+	single := FromSlice([]int{1})
+	node, _ := single.GetNode(0)
+	node.Next = node
+
+	if !single.HasCycle() {
+		t.Error("expected self-loop to be detected")
+	}
+}
+
+func TestLinkedListClone(t *testing.T) {
+	original := FromSlice([]int{1, 2, 3})
+	clone := original.Clone()
+
+	if !reflect.DeepEqual(original.ToSlice(), clone.ToSlice()) {
+		t.Error("clone should have same contents as original")
+	}
+
+	// Check that they are independent
+	clone.Append(4)
+	node, _ := clone.GetNode(0)
+	node.Value = 100
+
+	if !reflect.DeepEqual(original.ToSlice(), []int{1, 2, 3}) {
+		t.Errorf("clone should be independent of original, got %v", original.ToSlice())
+	}
+
+	tail, _ := clone.Tail()
+	if tail != 4 || clone.Size() != 4 {
+		t.Errorf("expected clone tail=4 and size 4, got %d and %d", tail, clone.Size())
+	}
+}
+
+func TestLinkedListCloneWith(t *testing.T) {
+	ll := NewLinkedList[[]int]()
+	ll.Append([]int{1, 2})
+	ll.Append([]int{3})
+
+	clone := ll.CloneWith(slices.Clone[[]int])
+	head, _ := clone.Head()
+	head[0] = 100
+
+	original, _ := ll.Head()
+	if original[0] != 1 {
+		t.Errorf("expected original value unchanged, got %v", original)
+	}
+
+	if clone.Size() != 2 {
+		t.Errorf("expected size 2, got %d", clone.Size())
+	}
+}
+
+func TestCloneSafe(t *testing.T) {
+	ll := FromSlice([]int{1, 2, 3})
+
+	clone, err := ll.CloneSafe()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(clone.ToSlice(), []int{1, 2, 3}) {
+		t.Errorf("expected [1 2 3], got %v", clone.ToSlice())
+	}
+
+	// Introduce a cycle; CloneSafe must error rather than hang
+	first, _ := ll.GetNode(0)
+	last, _ := ll.GetNode(2)
+	last.Next = first
+
+	clone, err = ll.CloneSafe()
+	if err == nil {
+		t.Error("expected error when cloning a list with a cycle")
+	}
+	if clone != nil {
+		t.Error("expected nil clone on error")
+	}
+}
+
+func TestGoString(t *testing.T) {
+	tests := []struct {
+		name     string
+		initial  []string
+		expected string
+	}{
+		{"empty list", []string{}, "[]"},
+		{"comma inside element", []string{"a, b", "c"}, `["a, b" -> "c"]`},
+		{"arrow and brackets inside element", []string{"[x -> y]"}, `["[x -> y]"]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ll := FromSlice(tt.initial)
+
+			if result := ll.GoString(); result != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestHeadTailNode(t *testing.T) {
+	empty := NewLinkedList[int]()
+	if empty.HeadNode() != nil || empty.TailNode() != nil {
+		t.Error("expected nil head and tail nodes for empty list")
+	}
+
+	var zero LinkedList[int]
+	if zero.HeadNode() != nil || zero.TailNode() != nil {
+		t.Error("expected nil head and tail nodes for zero-value list")
+	}
+
+	ll := FromSlice([]int{10, 20, 30})
+
+	head := ll.HeadNode()
+	if head == nil || head.Value != 10 {
+		t.Fatalf("expected head node value 10, got %v", head)
+	}
+
+	tail := ll.TailNode()
+	if tail == nil || tail.Value != 30 || tail.Next != nil {
+		t.Fatalf("expected tail node value 30 with nil Next, got %v", tail)
+	}
+
+	// Manual traversal from the head visits every element
+	var values []int
+	for node := ll.HeadNode(); node != nil; node = node.Next {
+		values = append(values, node.Value)
+	}
+	if !reflect.DeepEqual(values, []int{10, 20, 30}) {
+		t.Errorf("expected [10 20 30], got %v", values)
+	}
+
+	// Single element: head and tail are the same node
+	single := FromSlice([]int{1})
+	if single.HeadNode() != single.TailNode() {
+		t.Error("expected head and tail to be the same node")
+	}
+}
+
+func TestMapList(t *testing.T) {
+	original := FromSlice([]int{1, 2, 3})
+	mapped := MapList(original, func(v int) string { return strings.Repeat("*", v) })
+
+	expected := []string{"*", "**", "***"}
+	if !reflect.DeepEqual(mapped.ToSlice(), expected) {
+		t.Errorf("expected %v, got %v", expected, mapped.ToSlice())
+	}
+
+	if mapped.Size() != 3 {
+		t.Errorf("expected size 3, got %d", mapped.Size())
+	}
+
+	if tail, _ := mapped.Tail(); tail != "***" {
+		t.Errorf("expected tail ***, got %s", tail)
+	}
+
+	// Original is untouched
+	if !reflect.DeepEqual(original.ToSlice(), []int{1, 2, 3}) {
+		t.Errorf("expected original unchanged, got %v", original.ToSlice())
+	}
+
+	empty := MapList(NewLinkedList[int](), func(v int) string { return "x" })
+	if !empty.IsEmpty() || empty.Size() != 0 {
+		t.Errorf("expected empty result, got %v", empty.ToSlice())
+	}
+}
+
+func TestLinkedListAll(t *testing.T) {
+	ll := FromSlice([]int{1, 2, 3})
+
+	result := slices.Collect(ll.All())
+	if !reflect.DeepEqual(result, []int{1, 2, 3}) {
+		t.Errorf("expected [1 2 3], got %v", result)
+	}
+
+	if len(slices.Collect(NewLinkedList[int]().All())) != 0 {
+		t.Error("expected no elements from empty list")
+	}
+}
+
+func TestIntersectionNode(t *testing.T) {
+	tests := []struct {
+		name   string
+		a      []int
+		b      []int
+		shared []int // Tail appended to b by relinking, taken from a
+	}{
+		{"no intersection", []int{1, 2, 3}, []int{4, 5}, nil},
+		{"equal lengths", []int{1, 2, 3, 4}, []int{9, 8}, []int{3, 4}},
+		{"very different lengths", []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, []int{20}, []int{9, 10}},
+		{"whole list shared", []int{1, 2, 3}, []int{7, 8, 9, 10}, []int{1, 2, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := FromSlice(tt.a), FromSlice(tt.b)
+
+			var want *Node[int]
+			if tt.shared != nil {
+				want, _ = a.GetNode(len(tt.a) - len(tt.shared))
+				last, _ := b.GetNode(len(tt.b) - 1)
+				last.Next = want
+			}
+
+			got, ok := IntersectionNode(a, b)
+			if ok != (want != nil) || got != want {
+				t.Fatalf("expected node %p (found=%v), got %p (found=%v)", want, want != nil, got, ok)
+			}
+
+			// Result must not depend on argument order
+			if got, _ := IntersectionNode(b, a); got != want {
+				t.Errorf("expected node %p with swapped arguments, got %p", want, got)
+			}
+		})
+	}
+
+	// Equal values in separate nodes are not an intersection
+	if _, ok := IntersectionNode(FromSlice([]int{1, 2}), FromSlice([]int{1, 2})); ok {
+		t.Error("expected value-equal but distinct lists not to intersect")
+	}
+
+	if _, ok := IntersectionNode(NewLinkedList[int](), FromSlice([]int{1})); ok {
+		t.Error("expected empty list not to intersect")
+	}
+}
+
+func TestSpliceInto(t *testing.T) {
+	tests := []struct {
+		name        string
+		start, end  int
+		expectedSrc []int
+		expectedDst []int
+	}{
+		{"middle range", 1, 3, []int{1, 4, 5}, []int{10, 2, 3}},
+		{"range at head", 0, 2, []int{3, 4, 5}, []int{10, 1, 2}},
+		{"range at tail", 3, 5, []int{1, 2, 3}, []int{10, 4, 5}},
+		{"full list", 0, 5, []int{}, []int{10, 1, 2, 3, 4, 5}},
+		{"empty range", 2, 2, []int{1, 2, 3, 4, 5}, []int{10}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := FromSlice([]int{1, 2, 3, 4, 5})
+			dst := FromSlice([]int{10})
+
+			if err := src.SpliceInto(dst, tt.start, tt.end); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(src.ToSlice(), tt.expectedSrc) || src.Size() != len(tt.expectedSrc) {
+				t.Errorf("expected source %v, got %v (size %d)", tt.expectedSrc, src.ToSlice(), src.Size())
+			}
+
+			if !reflect.DeepEqual(dst.ToSlice(), tt.expectedDst) || dst.Size() != len(tt.expectedDst) {
+				t.Errorf("expected destination %v, got %v (size %d)", tt.expectedDst, dst.ToSlice(), dst.Size())
+			}
+
+			// Tails must be correct for later appends
+			src.Append(99)
+			dst.Append(99)
+			if tail, _ := src.Tail(); tail != 99 || src.Size() != len(tt.expectedSrc)+1 {
+				t.Errorf("expected source to accept append, got %v", src.ToSlice())
+			}
+			if tail, _ := dst.Tail(); tail != 99 || dst.Size() != len(tt.expectedDst)+1 {
+				t.Errorf("expected destination to accept append, got %v", dst.ToSlice())
+			}
+		})
+	}
+
+	// A zero-value destination works too
+	src := FromSlice([]int{1, 2})
+	var dst LinkedList[int]
+	if err := src.SpliceInto(&dst, 0, 1); err != nil || !reflect.DeepEqual(dst.ToSlice(), []int{1}) {
+		t.Errorf("expected [1] in zero-value destination, got %v, error=%v", dst.ToSlice(), err)
+	}
+
+	for _, r := range [][2]int{{-1, 1}, {0, 3}, {2, 1}} {
+		if err := src.SpliceInto(NewLinkedList[int](), r[0], r[1]); err == nil {
+			t.Errorf("expected error for range [%d, %d)", r[0], r[1])
+		}
+	}
+
+	if err := src.SpliceInto(src, 0, 1); err == nil {
+		t.Error("expected error when splicing a list into itself")
+	}
+}
+
+func TestZip(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        []int
+		b        []string
+		expected []Pair[int, string]
+	}{
+		{"equal length", []int{1, 2}, []string{"a", "b"}, []Pair[int, string]{{1, "a"}, {2, "b"}}},
+		{"shorter a", []int{1}, []string{"a", "b", "c"}, []Pair[int, string]{{1, "a"}}},
+		{"shorter b", []int{1, 2, 3}, []string{"a", "b"}, []Pair[int, string]{{1, "a"}, {2, "b"}}},
+		{"empty input", []int{}, []string{"a"}, []Pair[int, string]{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Zip(FromSlice(tt.a), FromSlice(tt.b))
+
+			if !reflect.DeepEqual(result.ToSlice(), tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result.ToSlice())
+			}
+
+			if result.Size() != len(tt.expected) {
+				t.Errorf("expected size %d, got %d", len(tt.expected), result.Size())
+			}
+		})
+	}
+}
+
+func TestForEachNode(t *testing.T) {
+	ll := ListOf(1, 2, 3)
+	ll.ForEachNode(func(n *Node[int]) {
+		n.Value *= 10
+	})
+
+	if !reflect.DeepEqual(ll.ToSlice(), []int{10, 20, 30}) {
+		t.Errorf("expected [10 20 30], got %v", ll.ToSlice())
+	}
+
+	calls := 0
+	NewLinkedList[int]().ForEachNode(func(*Node[int]) { calls++ })
+
+	var zero LinkedList[int]
+	zero.ForEachNode(func(*Node[int]) { calls++ })
+
+	if calls != 0 {
+		t.Errorf("expected no calls on empty lists, got %d", calls)
+	}
+}
+
+func TestForEachReverse(t *testing.T) {
+	ll := FromSlice([]int{1, 2, 3, 4})
+
+	var visited []int
+	ll.ForEachReverse(func(v int) {
+		visited = append(visited, v)
+	})
+
+	if expected := []int{4, 3, 2, 1}; !reflect.DeepEqual(visited, expected) {
+		t.Errorf("expected %v, got %v", expected, visited)
+	}
+
+	if expected := []int{1, 2, 3, 4}; !reflect.DeepEqual(ll.ToSlice(), expected) {
+		t.Errorf("expected list unchanged as %v, got %v", expected, ll.ToSlice())
+	}
+
+	calls := 0
+	NewLinkedList[int]().ForEachReverse(func(int) { calls++ })
+	if calls != 0 {
+		t.Errorf("expected no calls on empty list, got %d", calls)
+	}
+}
+
+func TestCountValue(t *testing.T) {
+	c := ListOf(1, 2, 1, 3, 1, 2)
+
+	tests := []struct {
+		value    int
+		expected int
+	}{
+		{1, 3},
+		{2, 2},
+		{3, 1},
+		{4, 0},
+	}
+
+	for _, tt := range tests {
+		if got := c.CountValue(tt.value); got != tt.expected {
+			t.Errorf("expected %d occurrences of %d, got %d", tt.expected, tt.value, got)
+		}
+	}
+
+	if got := NewLinkedList[int]().CountValue(1); got != 0 {
+		t.Errorf("expected 0 on empty collection, got %d", got)
+	}
+}
+
+func TestLinkedListJSON(t *testing.T) {
+	type point struct {
+		X int    `json:"x"`
+		Y int    `json:"y"`
+		L string `json:"label"`
+	}
+
+	t.Run("structs", func(t *testing.T) {
+		ll := ListOf(point{1, 2, "a"}, point{3, 4, "b"})
+
+		data, err := json.Marshal(ll)
+		if err != nil {
+			t.Fatalf("unexpected marshal error: %v", err)
+		}
+
+		expected := `[{"x":1,"y":2,"label":"a"},{"x":3,"y":4,"label":"b"}]`
+		if string(data) != expected {
+			t.Errorf("expected %s, got %s", expected, data)
+		}
+
+		decoded := NewLinkedList[point]()
+		if err := json.Unmarshal(data, decoded); err != nil {
+			t.Fatalf("unexpected unmarshal error: %v", err)
+		}
+
+		if !reflect.DeepEqual(decoded.ToSlice(), ll.ToSlice()) || decoded.Size() != 2 {
+			t.Errorf("expected %v, got %v", ll.ToSlice(), decoded.ToSlice())
+		}
+
+		// The rebuilt chain has a working tail
+		decoded.Append(point{5, 6, "c"})
+		if tail, _ := decoded.Tail(); tail.L != "c" {
+			t.Errorf("expected tail c after append, got %v", tail)
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		data, err := json.Marshal(NewLinkedList[int]())
+		if err != nil || string(data) != "[]" {
+			t.Errorf("expected [], got %s, error=%v", data, err)
+		}
+
+		var decoded LinkedList[int]
+		if err := json.Unmarshal(data, &decoded); err != nil || !decoded.IsEmpty() {
+			t.Errorf("expected empty list, got %v, error=%v", decoded.ToSlice(), err)
+		}
+	})
+
+	t.Run("replaces contents", func(t *testing.T) {
+		ll := ListOf(9, 9)
+		if err := json.Unmarshal([]byte("[1,2,3]"), ll); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(ll.ToSlice(), []int{1, 2, 3}) {
+			t.Errorf("expected [1 2 3], got %v", ll.ToSlice())
+		}
+
+		if err := json.Unmarshal([]byte(`{"not":"array"}`), ll); err == nil {
+			t.Error("expected error for non-array JSON")
+		}
+		if !reflect.DeepEqual(ll.ToSlice(), []int{1, 2, 3}) {
+			t.Errorf("expected list unchanged after error, got %v", ll.ToSlice())
+		}
+	})
+
+	t.Run("value field", func(t *testing.T) {
+		type wrapper struct {
+			List LinkedList[int] `json:"list"`
+		}
+
+		var w wrapper
+		if err := json.Unmarshal([]byte(`{"list":[1,2,3]}`), &w); err != nil {
+			t.Fatalf("unexpected unmarshal error: %v", err)
+		}
+
+		// Marshalling w by value leaves the field unaddressable
+		data, err := json.Marshal(w)
+		if expected := `{"list":[1,2,3]}`; err != nil || string(data) != expected {
+			t.Errorf("expected %s, got %s, error=%v", expected, data, err)
+		}
+	})
+}
+
 // Benchmark tests
 func BenchmarkAppend(b *testing.B) {
 	ll := NewLinkedList[int]()
@@ -0,0 +1,84 @@
+package collections
+
+// ListCursor walks a LinkedList from head to tail and can edit it in place.
+// It keeps the list's size and tail consistent, making it a safer alternative to
+// relinking nodes obtained from GetNode. A new cursor is positioned before the head;
+// call Next to move onto each element in turn.
+// The list must not be modified other than through the cursor while it is in use.
+type ListCursor[T any] struct {
+	list    *LinkedList[T]
+	prev    *Node[T] // Node before current; the sentinel when current is the head
+	current *Node[T] // Element the cursor is on, or nil before the start, after the end or after Remove
+	started bool
+}
+
+// Cursor returns a new cursor positioned before the head of the list.
+func (ll *LinkedList[T]) Cursor() *ListCursor[T] {
+	ll.lazyInit()
+	return &ListCursor[T]{list: ll, prev: &ll.sentinel}
+}
+
+// Next moves the cursor to the following element and reports whether there is one.
+// After Remove, Next moves to the element that followed the removed one.
+// Time complexity: O(1)
+func (c *ListCursor[T]) Next() bool {
+	switch {
+	case !c.started:
+		c.started = true
+	case c.current != nil:
+		c.prev = c.current
+	case c.prev.Next == nil:
+		// Past the end, or the removed element was the tail
+		return false
+	}
+
+	c.current = c.prev.Next
+	return c.current != nil
+}
+
+// Value returns the element the cursor is on.
+// Returns the zero value if the cursor is not on an element.
+// Time complexity: O(1)
+func (c *ListCursor[T]) Value() T {
+	if c.current == nil {
+		var zero T
+		return zero
+	}
+	return c.current.Value
+}
+
+// SetValue replaces the element the cursor is on and returns true,
+// or returns false if the cursor is not on an element.
+// Time complexity: O(1)
+func (c *ListCursor[T]) SetValue(value T) bool {
+	if c.current == nil {
+		return false
+	}
+	c.current.Value = value
+	return true
+}
+
+// InsertAfter inserts value directly after the element the cursor is on and returns true,
+// or returns false if the cursor is not on an element. The cursor does not move, so the
+// next call to Next visits the inserted element.
+// Time complexity: O(1)
+func (c *ListCursor[T]) InsertAfter(value T) bool {
+	if c.current == nil {
+		return false
+	}
+	c.list.insertAfter(c.current, value)
+	return true
+}
+
+// Remove deletes the element the cursor is on and returns true, or returns false if the
+// cursor is not on an element. The cursor is then on no element until the next call
+// to Next, which moves to the element that followed the removed one.
+// Time complexity: O(1)
+func (c *ListCursor[T]) Remove() bool {
+	if c.current == nil {
+		return false
+	}
+	c.list.unlinkAfter(c.prev)
+	c.current = nil
+	return true
+}
@@ -0,0 +1,102 @@
+package collections
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestListCursorWalk(t *testing.T) {
+	ll := FromSlice([]int{1, 2, 3})
+	c := ll.Cursor()
+
+	var visited []int
+	for c.Next() {
+		visited = append(visited, c.Value())
+	}
+
+	if expected := []int{1, 2, 3}; !reflect.DeepEqual(visited, expected) {
+		t.Errorf("expected %v, got %v", expected, visited)
+	}
+
+	if c.Next() {
+		t.Error("expected Next to keep returning false past the end")
+	}
+
+	if c.Value() != 0 || c.SetValue(9) || c.InsertAfter(9) || c.Remove() {
+		t.Error("expected no-ops when the cursor is not on an element")
+	}
+
+	if NewLinkedList[int]().Cursor().Next() {
+		t.Error("expected Next to return false on an empty list")
+	}
+}
+
+func TestListCursorEdit(t *testing.T) {
+	ll := FromSlice([]int{1, 2, 3, 4, 5, 6})
+	c := ll.Cursor()
+
+	// Remove evens, duplicate multiples of three, and negate the rest
+	for c.Next() {
+		v := c.Value()
+		switch {
+		case v%2 == 0:
+			c.Remove()
+		case v%3 == 0:
+			c.InsertAfter(-v)
+			c.Next() // Skip over the inserted element
+		default:
+			c.SetValue(v * 10)
+		}
+	}
+
+	expected := []int{10, 3, -3, 50}
+	if result := ll.ToSlice(); !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+
+	if ll.Size() != len(expected) {
+		t.Errorf("expected size %d, got %d", len(expected), ll.Size())
+	}
+
+	// The tail was removed (6), so appending must follow the new last element
+	ll.Append(7)
+	if result := ll.ToSlice(); !reflect.DeepEqual(result, []int{10, 3, -3, 50, 7}) {
+		t.Errorf("expected tail to be updated, got %v", result)
+	}
+}
+
+func TestListCursorRemoveAll(t *testing.T) {
+	ll := FromSlice([]int{1, 2, 3})
+	c := ll.Cursor()
+
+	for c.Next() {
+		if !c.Remove() {
+			t.Errorf("expected Remove to succeed on %d", c.Value())
+		}
+	}
+
+	if !ll.IsEmpty() || ll.Size() != 0 {
+		t.Errorf("expected empty list, got %v", ll.ToSlice())
+	}
+
+	ll.Append(4)
+	if result := ll.ToSlice(); !reflect.DeepEqual(result, []int{4}) {
+		t.Errorf("expected [4] after append, got %v", result)
+	}
+}
+
+func TestListCursorInsertAfterTail(t *testing.T) {
+	ll := FromSlice([]int{1})
+	c := ll.Cursor()
+	c.Next()
+	c.InsertAfter(2)
+
+	if !c.Next() || c.Value() != 2 {
+		t.Errorf("expected Next to visit the inserted element, got %d", c.Value())
+	}
+
+	ll.Append(3)
+	if result := ll.ToSlice(); !reflect.DeepEqual(result, []int{1, 2, 3}) {
+		t.Errorf("expected [1 2 3], got %v", result)
+	}
+}
@@ -0,0 +1,43 @@
+package collections
+
+// Memo is a cache of computed results keyed by string, for memoizing recursive or
+// dynamic-programming solutions. Keys are typically built with the Fingerprint method
+// of a Queue or Deque, so a result computed for one collection state is reused for
+// any collection with the same contents.
+// A Memo is not safe for concurrent use.
+type Memo[R any] struct {
+	results map[string]R
+}
+
+// NewMemo creates and returns a new empty memo.
+func NewMemo[R any]() *Memo[R] {
+	return &Memo[R]{
+		results: make(map[string]R),
+	}
+}
+
+// Get returns the result stored under key and true, or the zero value and false
+// if nothing has been stored for key.
+// Time complexity: O(1) average, plus hashing the key
+func (m *Memo[R]) Get(key string) (R, bool) {
+	v, ok := m.results[key]
+	return v, ok
+}
+
+// Put stores v under key, replacing any result already stored there.
+// Time complexity: O(1) average, plus hashing the key
+func (m *Memo[R]) Put(key string, v R) {
+	m.results[key] = v
+}
+
+// Size returns the number of stored results.
+// Time complexity: O(1)
+func (m *Memo[R]) Size() int {
+	return len(m.results)
+}
+
+// Clear removes all stored results.
+// Time complexity: O(n)
+func (m *Memo[R]) Clear() {
+	clear(m.results)
+}
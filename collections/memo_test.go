@@ -0,0 +1,89 @@
+package collections
+
+import "testing"
+
+func TestMemo(t *testing.T) {
+	m := NewMemo[int]()
+
+	if _, ok := m.Get("missing"); ok {
+		t.Error("expected miss on empty memo")
+	}
+
+	m.Put("a", 1)
+	m.Put("a", 2)
+
+	if v, ok := m.Get("a"); !ok || v != 2 {
+		t.Errorf("expected 2, got %d (ok=%v)", v, ok)
+	}
+
+	if m.Size() != 1 {
+		t.Errorf("expected size 1, got %d", m.Size())
+	}
+
+	m.Clear()
+	if _, ok := m.Get("a"); ok || m.Size() != 0 {
+		t.Error("expected empty memo after Clear")
+	}
+}
+
+func TestMemoDequeFingerprint(t *testing.T) {
+	// Same contents, different internal offsets
+	a := DequeOf(1, 2, 3)
+
+	b := NewDequeWithCapacity[int](8)
+	b.PushBackN(0, 0, 1, 2)
+	b.PopFront()
+	b.PopFront()
+	b.PushBack(3)
+
+	c := DequeOf(3)
+	c.PushFront(2)
+	c.PushFront(1)
+
+	if a.Fingerprint() != b.Fingerprint() || a.Fingerprint() != c.Fingerprint() {
+		t.Errorf("expected equal fingerprints, got %q, %q and %q", a.Fingerprint(), b.Fingerprint(), c.Fingerprint())
+	}
+
+	m := NewMemo[int]()
+	computed := 0
+	sum := func(dq *Deque[int]) int {
+		if v, ok := m.Get(dq.Fingerprint()); ok {
+			return v
+		}
+		computed++
+		v := FoldDeque(dq, 0, func(acc, x int) int { return acc + x })
+		m.Put(dq.Fingerprint(), v)
+		return v
+	}
+
+	for _, dq := range []*Deque[int]{a, b, c} {
+		if v := sum(dq); v != 6 {
+			t.Errorf("expected 6, got %d", v)
+		}
+	}
+
+	if computed != 1 {
+		t.Errorf("expected one computation and two cache hits, got %d computations", computed)
+	}
+
+	if DequeOf(1, 2).Fingerprint() == DequeOf(2, 1).Fingerprint() {
+		t.Error("expected different fingerprints for different orders")
+	}
+}
+
+func TestQueueFingerprint(t *testing.T) {
+	a := QueueOf("x", "y")
+
+	b := QueueOf("w", "x")
+	b.Dequeue()
+	b.Enqueue("y")
+
+	if a.Fingerprint() != b.Fingerprint() {
+		t.Errorf("expected equal fingerprints, got %q and %q", a.Fingerprint(), b.Fingerprint())
+	}
+
+	// Quoting keeps separators inside elements unambiguous
+	if QueueOf("a, b").Fingerprint() == QueueOf("a", "b").Fingerprint() {
+		t.Error("expected different fingerprints for different elements")
+	}
+}
@@ -0,0 +1,91 @@
+package collections
+
+// MonotonicStack represents a stack whose elements stay ordered from bottom to top.
+// Before each push, elements that may not sit below the new value are popped, which
+// is the core step of next-greater-element, stock-span and largest-rectangle problems.
+// Every element is pushed and popped at most once, so n pushes cost O(n) in total.
+type MonotonicStack[T any] struct {
+	stack *Stack[T]
+}
+
+// NewMonotonicStack creates and returns a new empty monotonic stack.
+func NewMonotonicStack[T any]() *MonotonicStack[T] {
+	return &MonotonicStack[T]{
+		stack: NewStack[T](),
+	}
+}
+
+// PushPopping pops elements from the top while keep(top, value) returns false, then
+// pushes value. keep reports whether top may stay below value; for example
+// func(top, v int) bool { return top >= v } keeps the stack non-increasing.
+// Returns the popped elements in the order they were popped, or nil if none were.
+// Time complexity: O(1) amortized
+func (ms *MonotonicStack[T]) PushPopping(value T, keep func(top, value T) bool) []T {
+	var popped []T
+
+	for !ms.stack.IsEmpty() {
+		top, _ := ms.stack.Peek()
+		if keep(top, value) {
+			break
+		}
+		ms.stack.Pop()
+		popped = append(popped, top)
+	}
+
+	ms.stack.Push(value)
+	return popped
+}
+
+// Pop removes and returns the top element from the stack.
+// Returns an error if the stack is empty.
+// Time complexity: O(1)
+func (ms *MonotonicStack[T]) Pop() (T, error) {
+	return ms.stack.Pop()
+}
+
+// Peek returns the top element without removing it.
+// Returns an error if the stack is empty.
+// Time complexity: O(1)
+func (ms *MonotonicStack[T]) Peek() (T, error) {
+	return ms.stack.Peek()
+}
+
+// Size returns the number of elements in the stack.
+// Time complexity: O(1)
+func (ms *MonotonicStack[T]) Size() int {
+	return ms.stack.Size()
+}
+
+// IsEmpty returns true if the stack is empty.
+// Time complexity: O(1)
+func (ms *MonotonicStack[T]) IsEmpty() bool {
+	return ms.stack.IsEmpty()
+}
+
+// ToSlice returns a copy of the stack as a slice, bottom first.
+// Time complexity: O(n)
+func (ms *MonotonicStack[T]) ToSlice() []T {
+	return ms.stack.ToSlice()
+}
+
+// NextGreaterElements returns, for each element of nums, the first element to its right
+// that is strictly greater, or -1 if there is none.
+// Time complexity: O(n)
+func NextGreaterElements(nums []int) []int {
+	result := make([]int, len(nums))
+	for i := range result {
+		result[i] = -1
+	}
+
+	// Indices still waiting for a greater element, with non-increasing values
+	waiting := NewMonotonicStack[int]()
+	keep := func(top, i int) bool { return nums[top] >= nums[i] }
+
+	for i := range nums {
+		for _, j := range waiting.PushPopping(i, keep) {
+			result[j] = nums[i]
+		}
+	}
+
+	return result
+}
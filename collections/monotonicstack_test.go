@@ -0,0 +1,59 @@
+package collections
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMonotonicStackPushPopping(t *testing.T) {
+	ms := NewMonotonicStack[int]()
+	nonIncreasing := func(top, v int) bool { return top >= v }
+
+	tests := []struct {
+		push     int
+		popped   []int
+		expected []int
+	}{
+		{5, nil, []int{5}},
+		{3, nil, []int{5, 3}},
+		{3, nil, []int{5, 3, 3}},
+		{4, []int{3, 3}, []int{5, 4}},
+		{9, []int{4, 5}, []int{9}},
+	}
+
+	for _, tt := range tests {
+		popped := ms.PushPopping(tt.push, nonIncreasing)
+		if !reflect.DeepEqual(popped, tt.popped) {
+			t.Errorf("push %d: expected popped %v, got %v", tt.push, tt.popped, popped)
+		}
+		if !reflect.DeepEqual(ms.ToSlice(), tt.expected) {
+			t.Errorf("push %d: expected stack %v, got %v", tt.push, tt.expected, ms.ToSlice())
+		}
+	}
+
+	if top, _ := ms.Peek(); top != 9 || ms.Size() != 1 {
+		t.Errorf("expected single element 9, got %v", ms.ToSlice())
+	}
+}
+
+func TestNextGreaterElements(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		expected []int
+	}{
+		{"mixed", []int{2, 1, 2, 4, 3}, []int{4, 2, 4, -1, -1}},
+		{"decreasing", []int{5, 4, 3, 2, 1}, []int{-1, -1, -1, -1, -1}},
+		{"increasing", []int{1, 2, 3}, []int{2, 3, -1}},
+		{"empty", []int{}, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := NextGreaterElements(tt.input)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package collections
+
+import "cmp"
+
+// Ordered is the constraint for types with a natural order: integers, floats and strings,
+// including named types built on them. It is the same set as cmp.Ordered.
+type Ordered = cmp.Ordered
+
+// Less reports whether a sorts before b in natural order.
+// NaN sorts before every other float, so Less is a strict weak ordering even for floats
+// and is safe to use as a comparator for heaps, trees and sorting.
+func Less[T Ordered](a, b T) bool {
+	return cmp.Less(a, b)
+}
+
+// DefaultLess returns Less[T] as a function value, for constructors that take a less
+// function such as NewPriorityQueue, NewAVLTree or Queue.Sort.
+func DefaultLess[T Ordered]() func(a, b T) bool {
+	return Less[T]
+}
@@ -0,0 +1,21 @@
+package collections
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLess(t *testing.T) {
+	if !Less(1, 2) || Less(2, 1) || Less(2, 2) {
+		t.Error("expected natural integer order")
+	}
+
+	if !Less("apple", "banana") {
+		t.Error("expected lexical string order")
+	}
+
+	less := DefaultLess[float64]()
+	if !less(math.NaN(), -1) || less(-1, math.NaN()) {
+		t.Error("expected NaN to sort before other floats")
+	}
+}
@@ -0,0 +1,144 @@
+package collections
+
+import (
+	"fmt"
+	"strings"
+)
+
+// orderedEntry is a key/value pair linked into the insertion order of an OrderedMap.
+type orderedEntry[K comparable, V any] struct {
+	key   K
+	value V
+	prev  *orderedEntry[K, V]
+	next  *orderedEntry[K, V]
+}
+
+// OrderedMap represents a map that preserves insertion order for iteration.
+// Implemented using a hash map for O(1) lookups plus a doubly linked list of entries.
+// Re-setting an existing key updates its value but keeps its original position.
+type OrderedMap[K comparable, V any] struct {
+	entries map[K]*orderedEntry[K, V]
+	head    *orderedEntry[K, V] // Oldest entry
+	tail    *orderedEntry[K, V] // Most recently inserted entry
+}
+
+// NewOrderedMap creates and returns a new empty ordered map.
+func NewOrderedMap[K comparable, V any]() *OrderedMap[K, V] {
+	return &OrderedMap[K, V]{
+		entries: make(map[K]*orderedEntry[K, V]),
+		head:    nil,
+		tail:    nil,
+	}
+}
+
+// OrderedMapFromPairs creates a new ordered map from pairs in order.
+// A repeated key takes the later value but keeps the position of its first occurrence,
+// exactly as if Set were called for each pair in turn.
+func OrderedMapFromPairs[K comparable, V any](pairs ...Pair[K, V]) *OrderedMap[K, V] {
+	om := NewOrderedMap[K, V]()
+	for _, p := range pairs {
+		om.Set(p.First, p.Second)
+	}
+	return om
+}
+
+// Set associates value with key.
+// New keys are added at the end of the iteration order; existing keys keep their position.
+// Time complexity: O(1)
+func (om *OrderedMap[K, V]) Set(key K, value V) {
+	if entry, ok := om.entries[key]; ok {
+		entry.value = value
+		return
+	}
+
+	entry := &orderedEntry[K, V]{key: key, value: value, prev: om.tail}
+	if om.tail == nil {
+		om.head = entry
+	} else {
+		om.tail.next = entry
+	}
+	om.tail = entry
+	om.entries[key] = entry
+}
+
+// Get returns the value associated with key and whether it was present.
+// Time complexity: O(1)
+func (om *OrderedMap[K, V]) Get(key K) (V, bool) {
+	entry, ok := om.entries[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	return entry.value, true
+}
+
+// Delete removes key from the map and returns true if it was present.
+// Time complexity: O(1)
+func (om *OrderedMap[K, V]) Delete(key K) bool {
+	entry, ok := om.entries[key]
+	if !ok {
+		return false
+	}
+
+	if entry.prev == nil {
+		om.head = entry.next
+	} else {
+		entry.prev.next = entry.next
+	}
+
+	if entry.next == nil {
+		om.tail = entry.prev
+	} else {
+		entry.next.prev = entry.prev
+	}
+
+	delete(om.entries, key)
+	return true
+}
+
+// Len returns the number of entries in the map.
+// Time complexity: O(1)
+func (om *OrderedMap[K, V]) Len() int {
+	return len(om.entries)
+}
+
+// Keys returns the keys in insertion order.
+// Time complexity: O(n)
+func (om *OrderedMap[K, V]) Keys() []K {
+	result := make([]K, 0, len(om.entries))
+	for entry := om.head; entry != nil; entry = entry.next {
+		result = append(result, entry.key)
+	}
+	return result
+}
+
+// Values returns the values in insertion order of their keys.
+// Time complexity: O(n)
+func (om *OrderedMap[K, V]) Values() []V {
+	result := make([]V, 0, len(om.entries))
+	for entry := om.head; entry != nil; entry = entry.next {
+		result = append(result, entry.value)
+	}
+	return result
+}
+
+// String returns a string representation of the ordered map.
+// Shows entries in insertion order.
+func (om *OrderedMap[K, V]) String() string {
+	if len(om.entries) == 0 {
+		return "OrderedMap[]"
+	}
+
+	var sb strings.Builder
+	sb.WriteString("OrderedMap[")
+
+	for entry := om.head; entry != nil; entry = entry.next {
+		if entry != om.head {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("%v: %v", entry.key, entry.value))
+	}
+
+	sb.WriteString("]")
+	return sb.String()
+}
@@ -0,0 +1,168 @@
+package collections
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewOrderedMap(t *testing.T) {
+	om := NewOrderedMap[string, int]()
+
+	if om.Len() != 0 {
+		t.Errorf("expected length 0, got %d", om.Len())
+	}
+
+	if len(om.Keys()) != 0 {
+		t.Errorf("expected no keys, got %v", om.Keys())
+	}
+}
+
+func TestOrderedMapSetGet(t *testing.T) {
+	om := NewOrderedMap[string, int]()
+	om.Set("one", 1)
+	om.Set("two", 2)
+
+	value, ok := om.Get("two")
+	if !ok || value != 2 {
+		t.Errorf("expected value=2, ok=true, got %d, %t", value, ok)
+	}
+
+	_, ok = om.Get("missing")
+	if ok {
+		t.Error("expected missing key to return ok=false")
+	}
+}
+
+func TestOrderedMapInsertionOrder(t *testing.T) {
+	om := NewOrderedMap[string, int]()
+	for i, key := range []string{"zeta", "alpha", "mid", "beta", "omega"} {
+		om.Set(key, i)
+	}
+
+	expectedKeys := []string{"zeta", "alpha", "mid", "beta", "omega"}
+	expectedValues := []int{0, 1, 2, 3, 4}
+
+	// Iteration order must be deterministic across repeated calls
+	for i := 0; i < 10; i++ {
+		if !reflect.DeepEqual(om.Keys(), expectedKeys) {
+			t.Fatalf("expected keys %v, got %v", expectedKeys, om.Keys())
+		}
+	}
+
+	if !reflect.DeepEqual(om.Values(), expectedValues) {
+		t.Errorf("expected values %v, got %v", expectedValues, om.Values())
+	}
+}
+
+func TestOrderedMapUpdateKeepsPosition(t *testing.T) {
+	om := NewOrderedMap[string, int]()
+	om.Set("a", 1)
+	om.Set("b", 2)
+	om.Set("c", 3)
+
+	om.Set("a", 100)
+
+	expectedKeys := []string{"a", "b", "c"}
+	if !reflect.DeepEqual(om.Keys(), expectedKeys) {
+		t.Errorf("expected keys %v, got %v", expectedKeys, om.Keys())
+	}
+
+	expectedValues := []int{100, 2, 3}
+	if !reflect.DeepEqual(om.Values(), expectedValues) {
+		t.Errorf("expected values %v, got %v", expectedValues, om.Values())
+	}
+
+	if om.Len() != 3 {
+		t.Errorf("expected length 3, got %d", om.Len())
+	}
+}
+
+func TestOrderedMapDelete(t *testing.T) {
+	tests := []struct {
+		name     string
+		remove   []string
+		expected []string
+	}{
+		{"delete head", []string{"a"}, []string{"b", "c", "d"}},
+		{"delete middle", []string{"b"}, []string{"a", "c", "d"}},
+		{"delete tail", []string{"d"}, []string{"a", "b", "c"}},
+		{"delete all", []string{"a", "b", "c", "d"}, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			om := NewOrderedMap[string, int]()
+			for i, key := range []string{"a", "b", "c", "d"} {
+				om.Set(key, i)
+			}
+
+			for _, key := range tt.remove {
+				if !om.Delete(key) {
+					t.Errorf("expected delete of %q to succeed", key)
+				}
+			}
+
+			if !reflect.DeepEqual(om.Keys(), tt.expected) {
+				t.Errorf("expected keys %v, got %v", tt.expected, om.Keys())
+			}
+
+			if om.Len() != len(tt.expected) {
+				t.Errorf("expected length %d, got %d", len(tt.expected), om.Len())
+			}
+		})
+	}
+
+	om := NewOrderedMap[string, int]()
+	if om.Delete("missing") {
+		t.Error("expected delete of missing key to return false")
+	}
+}
+
+func TestOrderedMapReinsertAfterDelete(t *testing.T) {
+	om := NewOrderedMap[string, int]()
+	om.Set("a", 1)
+	om.Set("b", 2)
+	om.Delete("a")
+	om.Set("a", 3)
+
+	expected := []string{"b", "a"}
+	if !reflect.DeepEqual(om.Keys(), expected) {
+		t.Errorf("expected keys %v, got %v", expected, om.Keys())
+	}
+}
+
+func TestOrderedMapString(t *testing.T) {
+	om := NewOrderedMap[string, int]()
+	if om.String() != "OrderedMap[]" {
+		t.Errorf("expected OrderedMap[], got %s", om.String())
+	}
+
+	om.Set("b", 2)
+	om.Set("a", 1)
+
+	expected := "OrderedMap[b: 2, a: 1]"
+	if om.String() != expected {
+		t.Errorf("expected %s, got %s", expected, om.String())
+	}
+}
+
+func TestOrderedMapFromPairs(t *testing.T) {
+	om := OrderedMapFromPairs(
+		Pair[string, int]{"one", 1},
+		Pair[string, int]{"two", 2},
+		Pair[string, int]{"three", 3},
+		Pair[string, int]{"one", 100},
+	)
+
+	if !reflect.DeepEqual(om.Keys(), []string{"one", "two", "three"}) {
+		t.Errorf("expected keys [one two three], got %v", om.Keys())
+	}
+
+	if !reflect.DeepEqual(om.Values(), []int{100, 2, 3}) {
+		t.Errorf("expected values [100 2 3], got %v", om.Values())
+	}
+
+	if OrderedMapFromPairs[string, int]().Len() != 0 {
+		t.Error("expected empty map from no pairs")
+	}
+}
@@ -0,0 +1,20 @@
+package collections
+
+import "fmt"
+
+// Pair holds two values of possibly different types.
+// It is the element type returned by helpers such as Zip.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// MakePair creates a pair from first and second, letting the type arguments be inferred.
+func MakePair[A, B any](first A, second B) Pair[A, B] {
+	return Pair[A, B]{First: first, Second: second}
+}
+
+// String returns a string representation of the pair, such as "(1, a)".
+func (p Pair[A, B]) String() string {
+	return fmt.Sprintf("(%v, %v)", p.First, p.Second)
+}
@@ -0,0 +1,52 @@
+package collections
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestMakePair(t *testing.T) {
+	p := MakePair(1, "a")
+
+	if p.First != 1 || p.Second != "a" {
+		t.Errorf("expected (1, a), got First=%v Second=%v", p.First, p.Second)
+	}
+
+	if p != (Pair[int, string]{First: 1, Second: "a"}) {
+		t.Error("expected MakePair to equal the literal pair")
+	}
+}
+
+func TestPairString(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    fmt.Stringer
+		expected string
+	}{
+		{"int and string", MakePair(1, "a"), "(1, a)"},
+		{"nested", MakePair(MakePair(1, 2), []int{3}), "((1, 2), [3])"},
+		{"zero values", Pair[string, *int]{}, "(, <nil>)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.input.String(); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestPairInLinkedList(t *testing.T) {
+	ll := ListOf(MakePair(1, "one"), MakePair(2, "two"))
+	ll.Append(MakePair(3, "three"))
+
+	expected := "[(1, one) -> (2, two) -> (3, three)]"
+	if ll.String() != expected {
+		t.Errorf("expected %q, got %q", expected, ll.String())
+	}
+
+	if idx := ll.Find(MakePair(2, "two")); idx != 1 {
+		t.Errorf("expected pair at index 1, got %d", idx)
+	}
+}
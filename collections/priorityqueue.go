@@ -0,0 +1,371 @@
+package collections
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PriorityQueue represents a priority queue with generic type support.
+// Implemented as a binary heap stored in a slice; the element for which less
+// reports true against all others is always at the top.
+type PriorityQueue[T any] struct {
+	items []T
+	less  func(a, b T) bool
+}
+
+// NewPriorityQueue creates and returns a new empty priority queue ordered by less.
+// Use a less function of a < b for a min-heap and a > b for a max-heap.
+func NewPriorityQueue[T any](less func(a, b T) bool) *PriorityQueue[T] {
+	return &PriorityQueue[T]{
+		items: make([]T, 0),
+		less:  less,
+	}
+}
+
+// NewMinPriorityQueue creates and returns a new empty priority queue of naturally ordered
+// values with the smallest element at the top, without the caller supplying a comparator.
+func NewMinPriorityQueue[T Ordered]() *PriorityQueue[T] {
+	return NewPriorityQueue(DefaultLess[T]())
+}
+
+// NewPriorityQueueFromSlice creates a priority queue holding a copy of data, ordered by less.
+// The heap is built bottom-up in a single pass, which is cheaper than pushing each
+// element in turn. The caller's slice is not modified.
+// Time complexity: O(n)
+func NewPriorityQueueFromSlice[T any](data []T, less func(a, b T) bool) *PriorityQueue[T] {
+	items := make([]T, len(data))
+	copy(items, data)
+
+	pq := &PriorityQueue[T]{
+		items: items,
+		less:  less,
+	}
+
+	// Sift down every internal node, starting from the last one
+	for i := len(items)/2 - 1; i >= 0; i-- {
+		pq.down(i)
+	}
+
+	return pq
+}
+
+// Push adds an element to the priority queue.
+// Time complexity: O(log n)
+func (pq *PriorityQueue[T]) Push(value T) {
+	pq.items = append(pq.items, value)
+	pq.up(len(pq.items) - 1)
+}
+
+// Pop removes and returns the highest-priority element.
+// Returns an error if the priority queue is empty.
+// Time complexity: O(log n)
+func (pq *PriorityQueue[T]) Pop() (T, error) {
+	var zero T
+
+	if len(pq.items) == 0 {
+		return zero, fmt.Errorf("priority queue: %w", ErrEmpty)
+	}
+
+	last := len(pq.items) - 1
+	value := pq.items[0]
+	pq.items[0] = pq.items[last]
+	pq.items[last] = zero // Clear reference for GC
+	pq.items = pq.items[:last]
+
+	if last > 0 {
+		pq.down(0)
+	}
+
+	return value, nil
+}
+
+// Peek returns the highest-priority element without removing it.
+// Returns an error if the priority queue is empty.
+// Time complexity: O(1)
+func (pq *PriorityQueue[T]) Peek() (T, error) {
+	var zero T
+
+	if len(pq.items) == 0 {
+		return zero, fmt.Errorf("priority queue: %w", ErrEmpty)
+	}
+
+	return pq.items[0], nil
+}
+
+// Size returns the number of elements in the priority queue.
+// Time complexity: O(1)
+func (pq *PriorityQueue[T]) Size() int {
+	return len(pq.items)
+}
+
+// IsEmpty returns true if the priority queue is empty.
+// Time complexity: O(1)
+func (pq *PriorityQueue[T]) IsEmpty() bool {
+	return len(pq.items) == 0
+}
+
+// Clear removes all elements from the priority queue.
+// Time complexity: O(n)
+func (pq *PriorityQueue[T]) Clear() {
+	var zero T
+	// Clear references for GC
+	for i := range pq.items {
+		pq.items[i] = zero
+	}
+	pq.items = pq.items[:0]
+}
+
+// ToSlice returns a copy of the underlying heap as a slice.
+// The first element is the highest-priority element; the rest are in heap order, not sorted.
+// Time complexity: O(n)
+func (pq *PriorityQueue[T]) ToSlice() []T {
+	result := make([]T, len(pq.items))
+	copy(result, pq.items)
+	return result
+}
+
+// String returns a string representation of the priority queue.
+// Shows elements in heap order.
+func (pq *PriorityQueue[T]) String() string {
+	if len(pq.items) == 0 {
+		return "PriorityQueue[]"
+	}
+
+	var sb strings.Builder
+	sb.WriteString("PriorityQueue[")
+
+	for i, item := range pq.items {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("%v", item))
+	}
+
+	sb.WriteString("] (top first)")
+	return sb.String()
+}
+
+// up moves the element at index i towards the root until the heap property holds.
+func (pq *PriorityQueue[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !pq.less(pq.items[i], pq.items[parent]) {
+			break
+		}
+		pq.items[i], pq.items[parent] = pq.items[parent], pq.items[i]
+		i = parent
+	}
+}
+
+// down moves the element at index i towards the leaves until the heap property holds.
+func (pq *PriorityQueue[T]) down(i int) {
+	n := len(pq.items)
+	for {
+		smallest := i
+		left := 2*i + 1
+		right := left + 1
+
+		if left < n && pq.less(pq.items[left], pq.items[smallest]) {
+			smallest = left
+		}
+		if right < n && pq.less(pq.items[right], pq.items[smallest]) {
+			smallest = right
+		}
+		if smallest == i {
+			return
+		}
+
+		pq.items[i], pq.items[smallest] = pq.items[smallest], pq.items[i]
+		i = smallest
+	}
+}
+
+// indexedItem pairs a value with its priority inside an IndexedPriorityQueue.
+type indexedItem[T comparable] struct {
+	value    T
+	priority float64
+}
+
+// IndexedPriorityQueue represents a min-priority queue that supports changing the
+// priority of an element already in the queue (decrease-key), as needed by Dijkstra and A*.
+// Each element maps to its heap position, so T must be comparable and elements are unique:
+// the queue holds at most one entry per value, and Update/Remove locate entries by value.
+type IndexedPriorityQueue[T comparable] struct {
+	items []indexedItem[T]
+	index map[T]int // Heap position of each element
+}
+
+// NewIndexedPriorityQueue creates and returns a new empty indexed priority queue.
+// Elements with the lowest priority value are popped first.
+func NewIndexedPriorityQueue[T comparable]() *IndexedPriorityQueue[T] {
+	return &IndexedPriorityQueue[T]{
+		items: make([]indexedItem[T], 0),
+		index: make(map[T]int),
+	}
+}
+
+// Push adds an element with the given priority.
+// If the element is already present, its priority is updated instead.
+// Time complexity: O(log n)
+func (pq *IndexedPriorityQueue[T]) Push(value T, priority float64) {
+	if _, ok := pq.index[value]; ok {
+		_ = pq.Update(value, priority)
+		return
+	}
+
+	pq.items = append(pq.items, indexedItem[T]{value: value, priority: priority})
+	pq.index[value] = len(pq.items) - 1
+	pq.up(len(pq.items) - 1)
+}
+
+// Update changes the priority of an element already in the queue.
+// Both decreasing and increasing the priority are supported.
+// Returns an error if the element is not present.
+// Time complexity: O(log n)
+func (pq *IndexedPriorityQueue[T]) Update(value T, priority float64) error {
+	i, ok := pq.index[value]
+	if !ok {
+		return fmt.Errorf("%w: %v in priority queue", ErrNotFound, value)
+	}
+
+	old := pq.items[i].priority
+	pq.items[i].priority = priority
+
+	if priority < old {
+		pq.up(i)
+	} else {
+		pq.down(i)
+	}
+
+	return nil
+}
+
+// Pop removes and returns the element with the lowest priority value along with its priority.
+// Returns an error if the priority queue is empty.
+// Time complexity: O(log n)
+func (pq *IndexedPriorityQueue[T]) Pop() (T, float64, error) {
+	var zero T
+
+	if len(pq.items) == 0 {
+		return zero, 0, fmt.Errorf("priority queue: %w", ErrEmpty)
+	}
+
+	top := pq.items[0]
+	pq.removeAt(0)
+	return top.value, top.priority, nil
+}
+
+// Peek returns the element with the lowest priority value and its priority without removing it.
+// Returns an error if the priority queue is empty.
+// Time complexity: O(1)
+func (pq *IndexedPriorityQueue[T]) Peek() (T, float64, error) {
+	var zero T
+
+	if len(pq.items) == 0 {
+		return zero, 0, fmt.Errorf("priority queue: %w", ErrEmpty)
+	}
+
+	return pq.items[0].value, pq.items[0].priority, nil
+}
+
+// Remove deletes an element from the queue and returns true if it was present.
+// Time complexity: O(log n)
+func (pq *IndexedPriorityQueue[T]) Remove(value T) bool {
+	i, ok := pq.index[value]
+	if !ok {
+		return false
+	}
+
+	pq.removeAt(i)
+	return true
+}
+
+// Contains checks if the element is in the queue.
+// Time complexity: O(1)
+func (pq *IndexedPriorityQueue[T]) Contains(value T) bool {
+	_, ok := pq.index[value]
+	return ok
+}
+
+// Priority returns the current priority of an element and whether it is present.
+// Time complexity: O(1)
+func (pq *IndexedPriorityQueue[T]) Priority(value T) (float64, bool) {
+	i, ok := pq.index[value]
+	if !ok {
+		return 0, false
+	}
+	return pq.items[i].priority, true
+}
+
+// Size returns the number of elements in the queue.
+// Time complexity: O(1)
+func (pq *IndexedPriorityQueue[T]) Size() int {
+	return len(pq.items)
+}
+
+// IsEmpty returns true if the queue is empty.
+// Time complexity: O(1)
+func (pq *IndexedPriorityQueue[T]) IsEmpty() bool {
+	return len(pq.items) == 0
+}
+
+// removeAt removes the entry at heap position i and restores the heap property.
+func (pq *IndexedPriorityQueue[T]) removeAt(i int) {
+	last := len(pq.items) - 1
+	removed := pq.items[i].value
+
+	if i != last {
+		pq.swap(i, last)
+	}
+
+	pq.items[last] = indexedItem[T]{} // Clear reference for GC
+	pq.items = pq.items[:last]
+	delete(pq.index, removed)
+
+	if i < last {
+		pq.down(i)
+		pq.up(i)
+	}
+}
+
+// swap exchanges two heap entries and keeps the index map in sync.
+func (pq *IndexedPriorityQueue[T]) swap(i, j int) {
+	pq.items[i], pq.items[j] = pq.items[j], pq.items[i]
+	pq.index[pq.items[i].value] = i
+	pq.index[pq.items[j].value] = j
+}
+
+// up moves the entry at index i towards the root until the heap property holds.
+func (pq *IndexedPriorityQueue[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if pq.items[i].priority >= pq.items[parent].priority {
+			break
+		}
+		pq.swap(i, parent)
+		i = parent
+	}
+}
+
+// down moves the entry at index i towards the leaves until the heap property holds.
+func (pq *IndexedPriorityQueue[T]) down(i int) {
+	n := len(pq.items)
+	for {
+		smallest := i
+		left := 2*i + 1
+		right := left + 1
+
+		if left < n && pq.items[left].priority < pq.items[smallest].priority {
+			smallest = left
+		}
+		if right < n && pq.items[right].priority < pq.items[smallest].priority {
+			smallest = right
+		}
+		if smallest == i {
+			return
+		}
+
+		pq.swap(i, smallest)
+		i = smallest
+	}
+}
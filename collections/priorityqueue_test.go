@@ -0,0 +1,317 @@
+package collections
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestNewPriorityQueue(t *testing.T) {
+	pq := NewPriorityQueue(func(a, b int) bool { return a < b })
+
+	if pq.Size() != 0 {
+		t.Errorf("expected size 0, got %d", pq.Size())
+	}
+
+	if !pq.IsEmpty() {
+		t.Error("expected empty priority queue")
+	}
+
+	_, err := pq.Pop()
+	if err == nil {
+		t.Error("expected error when popping empty priority queue")
+	}
+
+	_, err = pq.Peek()
+	if err == nil {
+		t.Error("expected error when peeking empty priority queue")
+	}
+}
+
+func TestNewMinPriorityQueue(t *testing.T) {
+	pq := NewMinPriorityQueue[int]()
+	for _, v := range []int{5, 1, 4, 2, 3} {
+		pq.Push(v)
+	}
+
+	var result []int
+	for !pq.IsEmpty() {
+		v, _ := pq.Pop()
+		result = append(result, v)
+	}
+
+	expected := []int{1, 2, 3, 4, 5}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestPriorityQueuePopOrder(t *testing.T) {
+	tests := []struct {
+		name     string
+		less     func(a, b int) bool
+		input    []int
+		expected []int
+	}{
+		{"min-heap", func(a, b int) bool { return a < b }, []int{5, 3, 8, 1, 9, 2, 7}, []int{1, 2, 3, 5, 7, 8, 9}},
+		{"max-heap", func(a, b int) bool { return a > b }, []int{5, 3, 8, 1, 9, 2, 7}, []int{9, 8, 7, 5, 3, 2, 1}},
+		{"duplicates", func(a, b int) bool { return a < b }, []int{2, 1, 2, 1}, []int{1, 1, 2, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pq := NewPriorityQueue(tt.less)
+			for _, v := range tt.input {
+				pq.Push(v)
+			}
+
+			top, err := pq.Peek()
+			if err != nil || top != tt.expected[0] {
+				t.Errorf("expected peek=%d, got %d, error=%v", tt.expected[0], top, err)
+			}
+
+			result := make([]int, 0, len(tt.input))
+			for !pq.IsEmpty() {
+				v, _ := pq.Pop()
+				result = append(result, v)
+			}
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestPriorityQueueClear(t *testing.T) {
+	pq := NewPriorityQueue(func(a, b int) bool { return a < b })
+	pq.Push(3)
+	pq.Push(1)
+	pq.Clear()
+
+	if !pq.IsEmpty() {
+		t.Error("expected empty priority queue after clear")
+	}
+
+	if pq.String() != "PriorityQueue[]" {
+		t.Errorf("expected PriorityQueue[], got %s", pq.String())
+	}
+}
+
+func TestIndexedPriorityQueueDecreaseKey(t *testing.T) {
+	pq := NewIndexedPriorityQueue[string]()
+	pq.Push("a", 5)
+	pq.Push("b", 3)
+	pq.Push("c", 8)
+	pq.Push("d", 6)
+
+	// Decrease c below everything else
+	if err := pq.Update("c", 1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []string{"c", "b", "a", "d"}
+	result := make([]string, 0, len(expected))
+	for !pq.IsEmpty() {
+		v, _, err := pq.Pop()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		result = append(result, v)
+	}
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestIndexedPriorityQueueIncreaseKey(t *testing.T) {
+	pq := NewIndexedPriorityQueue[int]()
+	for i := 1; i <= 5; i++ {
+		pq.Push(i, float64(i))
+	}
+
+	if err := pq.Update(1, 10); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	value, priority, err := pq.Peek()
+	if err != nil || value != 2 || priority != 2 {
+		t.Errorf("expected peek=(2, 2), got (%d, %v), error=%v", value, priority, err)
+	}
+
+	if p, ok := pq.Priority(1); !ok || p != 10 {
+		t.Errorf("expected priority 10 for 1, got %v, ok=%t", p, ok)
+	}
+}
+
+func TestIndexedPriorityQueuePushExisting(t *testing.T) {
+	pq := NewIndexedPriorityQueue[string]()
+	pq.Push("x", 4)
+	pq.Push("y", 2)
+	pq.Push("x", 1) // Acts as an update
+
+	if pq.Size() != 2 {
+		t.Errorf("expected size 2, got %d", pq.Size())
+	}
+
+	value, priority, _ := pq.Pop()
+	if value != "x" || priority != 1 {
+		t.Errorf("expected (x, 1), got (%s, %v)", value, priority)
+	}
+}
+
+func TestIndexedPriorityQueueRemove(t *testing.T) {
+	pq := NewIndexedPriorityQueue[int]()
+	for _, v := range []int{4, 1, 3, 2, 5} {
+		pq.Push(v, float64(v))
+	}
+
+	if !pq.Remove(3) {
+		t.Error("expected remove of 3 to succeed")
+	}
+
+	if pq.Remove(3) {
+		t.Error("expected second remove of 3 to fail")
+	}
+
+	if pq.Contains(3) {
+		t.Error("expected 3 to be absent after removal")
+	}
+
+	expected := []int{1, 2, 4, 5}
+	result := make([]int, 0, len(expected))
+	for !pq.IsEmpty() {
+		v, _, _ := pq.Pop()
+		result = append(result, v)
+	}
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestIndexedPriorityQueueErrors(t *testing.T) {
+	pq := NewIndexedPriorityQueue[string]()
+
+	if err := pq.Update("missing", 1); err == nil {
+		t.Error("expected error when updating missing value")
+	}
+
+	if _, _, err := pq.Pop(); err == nil {
+		t.Error("expected error when popping empty queue")
+	}
+
+	if _, _, err := pq.Peek(); err == nil {
+		t.Error("expected error when peeking empty queue")
+	}
+
+	if _, ok := pq.Priority("missing"); ok {
+		t.Error("expected missing value to have no priority")
+	}
+}
+
+func TestIndexedPriorityQueueDijkstra(t *testing.T) {
+	// Small weighted graph: shortest distances from "A"
+	edges := map[string]map[string]float64{
+		"A": {"B": 4, "C": 1},
+		"C": {"B": 2, "D": 5},
+		"B": {"D": 1},
+	}
+
+	dist := map[string]float64{"A": 0}
+	pq := NewIndexedPriorityQueue[string]()
+	pq.Push("A", 0)
+
+	for !pq.IsEmpty() {
+		node, d, _ := pq.Pop()
+		for next, w := range edges[node] {
+			if current, ok := dist[next]; !ok || d+w < current {
+				dist[next] = d + w
+				pq.Push(next, d+w)
+			}
+		}
+	}
+
+	expected := map[string]float64{"A": 0, "B": 3, "C": 1, "D": 4}
+	if !reflect.DeepEqual(dist, expected) {
+		t.Errorf("expected %v, got %v", expected, dist)
+	}
+}
+
+func TestNewPriorityQueueFromSlice(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+	}{
+		{"unsorted", []int{5, 3, 8, 1, 9, 2, 7, 3}},
+		{"already sorted", []int{1, 2, 3, 4, 5}},
+		{"reversed", []int{9, 8, 7, 6, 5, 4, 3, 2, 1, 0}},
+		{"single", []int{42}},
+		{"empty", []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := slices.Clone(tt.input)
+			pq := NewPriorityQueueFromSlice(tt.input, Less[int])
+
+			if pq.Size() != len(tt.input) {
+				t.Errorf("expected size %d, got %d", len(tt.input), pq.Size())
+			}
+
+			result := []int{}
+			for !pq.IsEmpty() {
+				v, _ := pq.Pop()
+				result = append(result, v)
+			}
+
+			expected := slices.Clone(tt.input)
+			slices.Sort(expected)
+			if !reflect.DeepEqual(result, expected) {
+				t.Errorf("expected %v, got %v", expected, result)
+			}
+
+			if !reflect.DeepEqual(tt.input, original) {
+				t.Errorf("expected input slice unchanged, got %v", tt.input)
+			}
+		})
+	}
+}
+
+// Benchmark tests
+func BenchmarkPriorityQueuePush(b *testing.B) {
+	pq := NewPriorityQueue(func(a, b int) bool { return a < b })
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		pq.Push(b.N - i)
+	}
+}
+
+func BenchmarkPriorityQueueFromSlice(b *testing.B) {
+	data := make([]int, 10000)
+	for i := range data {
+		data[i] = len(data) - i
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		NewPriorityQueueFromSlice(data, Less[int])
+	}
+}
+
+func BenchmarkPriorityQueuePushEach(b *testing.B) {
+	data := make([]int, 10000)
+	for i := range data {
+		data[i] = len(data) - i
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		pq := NewMinPriorityQueue[int]()
+		for _, v := range data {
+			pq.Push(v)
+		}
+	}
+}
@@ -2,6 +2,8 @@ package collections
 
 import (
 	"fmt"
+	"iter"
+	"sort"
 	"strings"
 )
 
@@ -18,9 +20,19 @@ const (
 // Implemented using a circular buffer with dynamic resizing for optimal performance.
 type Queue[T any] struct {
 	items []T
-	front int // Index of the front element
-	rear  int // Index where the next element will be inserted
-	size  int // Current number of elements
+	front int         // Index of the front element
+	rear  int         // Index where the next element will be inserted
+	size  int         // Current number of elements
+	stats *QueueStats // Optional throughput metrics, nil unless enabled
+
+	onChange func(op string, value T) // Optional mutation callback, nil unless registered
+}
+
+// QueueStats holds throughput metrics for a queue created with NewQueueWithStats.
+type QueueStats struct {
+	HighWaterMark int // Largest size the queue has reached
+	TotalEnqueued int // Number of elements ever enqueued
+	TotalDequeued int // Number of elements ever dequeued or drained
 }
 
 // NewQueue creates and returns a new empty queue.
@@ -46,6 +58,14 @@ func NewQueueWithCapacity[T any](capacity int) *Queue[T] {
 	}
 }
 
+// NewQueueWithStats creates a new empty queue that records QueueStats.
+// Tracking is opt-in; queues from the other constructors skip it entirely.
+func NewQueueWithStats[T any]() *Queue[T] {
+	q := NewQueue[T]()
+	q.stats = &QueueStats{}
+	return q
+}
+
 // FromSliceQueue creates a new queue from a slice.
 // The first element of the slice becomes the front of the queue.
 func FromSliceQueue[T any](slice []T) *Queue[T] {
@@ -57,7 +77,7 @@ func FromSliceQueue[T any](slice []T) *Queue[T] {
 	q := &Queue[T]{
 		items: make([]T, capacity),
 		front: 0,
-		rear:  len(slice),
+		rear:  len(slice) % capacity, // Wraps to 0 when the buffer is exactly full
 		size:  len(slice),
 	}
 
@@ -65,6 +85,20 @@ func FromSliceQueue[T any](slice []T) *Queue[T] {
 	return q
 }
 
+// QueueOf creates a new queue holding values, equivalent to FromSliceQueue(values).
+// The first value becomes the front of the queue.
+func QueueOf[T any](values ...T) *Queue[T] {
+	return FromSliceQueue(values)
+}
+
+// FromSeqQueue creates a new queue holding every element yielded by seq, in order.
+// Time complexity: O(n)
+func FromSeqQueue[T any](seq iter.Seq[T]) *Queue[T] {
+	q := NewQueue[T]()
+	q.EnqueueSeq(seq)
+	return q
+}
+
 // Enqueue adds an element to the rear of the queue.
 // Time complexity: O(1) amortized
 func (q *Queue[T]) Enqueue(value T) {
@@ -75,6 +109,51 @@ func (q *Queue[T]) Enqueue(value T) {
 	q.items[q.rear] = value
 	q.rear = (q.rear + 1) % len(q.items)
 	q.size++
+
+	if q.stats != nil {
+		q.stats.TotalEnqueued++
+		q.stats.HighWaterMark = max(q.stats.HighWaterMark, q.size)
+	}
+
+	if q.onChange != nil {
+		q.onChange("enqueue", value)
+	}
+}
+
+// EnqueueIfAbsent adds value to the rear only if an equal element is not already present.
+// Returns true if the value was added. Equality uses the same comparison as Contains,
+// so each call scans the whole queue.
+// Time complexity: O(n)
+func (q *Queue[T]) EnqueueIfAbsent(value T) bool {
+	if q.Contains(value) {
+		return false
+	}
+
+	q.Enqueue(value)
+	return true
+}
+
+// PutFront inserts an element at the front of the queue, so it is the next to be dequeued.
+// It lets an urgent item jump the FIFO order. Stats count it as an enqueue, and an
+// OnChange callback receives it with the operation name "putfront".
+// Time complexity: O(1) amortized
+func (q *Queue[T]) PutFront(value T) {
+	if q.size == len(q.items) {
+		q.resize()
+	}
+
+	q.front = (q.front - 1 + len(q.items)) % len(q.items)
+	q.items[q.front] = value
+	q.size++
+
+	if q.stats != nil {
+		q.stats.TotalEnqueued++
+		q.stats.HighWaterMark = max(q.stats.HighWaterMark, q.size)
+	}
+
+	if q.onChange != nil {
+		q.onChange("putfront", value)
+	}
 }
 
 // Dequeue removes and returns the front element from the queue.
@@ -84,7 +163,7 @@ func (q *Queue[T]) Dequeue() (T, error) {
 	var zero T
 
 	if q.size == 0 {
-		return zero, fmt.Errorf("queue is empty")
+		return zero, fmt.Errorf("queue: %w", ErrEmpty)
 	}
 
 	value := q.items[q.front]
@@ -93,6 +172,14 @@ func (q *Queue[T]) Dequeue() (T, error) {
 	q.front = (q.front + 1) % len(q.items)
 	q.size--
 
+	if q.stats != nil {
+		q.stats.TotalDequeued++
+	}
+
+	if q.onChange != nil {
+		q.onChange("dequeue", value)
+	}
+
 	// Shrink if queue is 1/4 full and capacity > 4
 	if q.size > 0 && q.size == len(q.items)/ShrinkFactor && len(q.items) > ShrinkFactor {
 		q.resize()
@@ -101,6 +188,20 @@ func (q *Queue[T]) Dequeue() (T, error) {
 	return value, nil
 }
 
+// Poll removes and returns the front element from the queue.
+// Returns the zero value and false if the queue is empty, avoiding the
+// error allocation of Dequeue in tight loops.
+// Time complexity: O(1)
+func (q *Queue[T]) Poll() (T, bool) {
+	if q.size == 0 {
+		var zero T
+		return zero, false
+	}
+
+	value, _ := q.Dequeue()
+	return value, true
+}
+
 // Front returns the front element without removing it.
 // Returns an error if the queue is empty.
 // Time complexity: O(1)
@@ -108,7 +209,7 @@ func (q *Queue[T]) Front() (T, error) {
 	var zero T
 
 	if q.size == 0 {
-		return zero, fmt.Errorf("queue is empty")
+		return zero, fmt.Errorf("queue: %w", ErrEmpty)
 	}
 
 	return q.items[q.front], nil
@@ -121,7 +222,7 @@ func (q *Queue[T]) Rear() (T, error) {
 	var zero T
 
 	if q.size == 0 {
-		return zero, fmt.Errorf("queue is empty")
+		return zero, fmt.Errorf("queue: %w", ErrEmpty)
 	}
 
 	rearIndex := (q.rear - 1 + len(q.items)) % len(q.items)
@@ -154,6 +255,37 @@ func (q *Queue[T]) Clear() {
 	q.size = 0
 }
 
+// RetainIf removes every element for which pred returns false, keeping the rest in FIFO order.
+// The circular buffer is compacted in place without allocating, and the number of
+// removed elements is returned. Like Clear, removals bypass Dequeue, so they are not
+// reported to stats or an OnChange callback.
+// Time complexity: O(n)
+func (q *Queue[T]) RetainIf(pred func(T) bool) int {
+	kept := 0
+	for i := 0; i < q.size; i++ {
+		value := q.items[(q.front+i)%len(q.items)]
+		if pred(value) {
+			q.items[(q.front+kept)%len(q.items)] = value
+			kept++
+		}
+	}
+
+	var zero T
+	// Clear the vacated slots for GC
+	for i := kept; i < q.size; i++ {
+		q.items[(q.front+i)%len(q.items)] = zero
+	}
+
+	removed := q.size - kept
+	q.size = kept
+	if kept == 0 {
+		q.front = 0
+	}
+	q.rear = (q.front + kept) % len(q.items)
+
+	return removed
+}
+
 // ToSlice returns a copy of the queue as a slice.
 // The first element is the front of the queue.
 // Time complexity: O(n)
@@ -168,6 +300,43 @@ func (q *Queue[T]) ToSlice() []T {
 	return result
 }
 
+// ToReversedSlice returns a copy of the queue as a slice without modifying the queue.
+// The first element is the rear of the queue, last element is the front.
+// Time complexity: O(n)
+func (q *Queue[T]) ToReversedSlice() []T {
+	result := make([]T, q.size)
+
+	for i := 0; i < q.size; i++ {
+		index := (q.front + q.size - 1 - i) % len(q.items)
+		result[i] = q.items[index]
+	}
+
+	return result
+}
+
+// All returns an iterator over the elements from front to rear.
+// The queue must not be modified during iteration.
+func (q *Queue[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < q.size; i++ {
+			if !yield(q.items[(q.front+i)%len(q.items)]) {
+				return
+			}
+		}
+	}
+}
+
+// ForEachUntil calls f for each element from front to rear, stopping as soon as f
+// returns false. It does not allocate.
+// Time complexity: O(n)
+func (q *Queue[T]) ForEachUntil(f func(T) bool) {
+	for i := 0; i < q.size; i++ {
+		if !f(q.items[(q.front+i)%len(q.items)]) {
+			return
+		}
+	}
+}
+
 // Contains checks if the queue contains the specified value.
 // Time complexity: O(n)
 func (q *Queue[T]) Contains(value T) bool {
@@ -180,9 +349,92 @@ func (q *Queue[T]) Contains(value T) bool {
 	return false
 }
 
+// CountValue returns the number of elements equal to value, compared the same way as Contains.
+// Time complexity: O(n)
+func (q *Queue[T]) CountValue(value T) int {
+	count := 0
+	for i := 0; i < q.size; i++ {
+		if isEqual(q.items[(q.front+i)%len(q.items)], value) {
+			count++
+		}
+	}
+	return count
+}
+
+// EqualAsMultiset reports whether q and other hold the same elements with the same
+// counts, regardless of order. Elements are compared the same way as Contains.
+// Time complexity: O(n + m)
+func (q *Queue[T]) EqualAsMultiset(other *Queue[T]) bool {
+	if q.size != other.size {
+		return false
+	}
+
+	counts := make(map[string]int, q.size)
+	for i := 0; i < q.size; i++ {
+		counts[fmt.Sprintf("%v", q.items[(q.front+i)%len(q.items)])]++
+	}
+
+	for i := 0; i < other.size; i++ {
+		key := fmt.Sprintf("%v", other.items[(other.front+i)%len(other.items)])
+		if counts[key] == 0 {
+			return false
+		}
+		counts[key]--
+	}
+
+	return true
+}
+
+// MapInPlace replaces every element with f applied to it, front to rear,
+// reusing the existing buffer.
+// Time complexity: O(n)
+func (q *Queue[T]) MapInPlace(f func(T) T) {
+	for i := 0; i < q.size; i++ {
+		index := (q.front + i) % len(q.items)
+		q.items[index] = f(q.items[index])
+	}
+}
+
+// ApplyE replaces each element with the result of f, front to rear, stopping at the
+// first error. Elements before the failing one stay transformed; the failing element
+// and everything after it are left unchanged. The returned error names the failing
+// front-relative index and wraps the error from f.
+// Time complexity: O(n)
+func (q *Queue[T]) ApplyE(f func(T) (T, error)) error {
+	for i := 0; i < q.size; i++ {
+		index := (q.front + i) % len(q.items)
+		value, err := f(q.items[index])
+		if err != nil {
+			return fmt.Errorf("apply failed at index %d: %w", i, err)
+		}
+		q.items[index] = value
+	}
+	return nil
+}
+
 // String returns a string representation of the queue.
 // Shows elements from front to rear.
 func (q *Queue[T]) String() string {
+	return q.format("%v")
+}
+
+// GoString returns a string representation of the queue with Go-syntax quoted elements,
+// so string elements containing separators remain unambiguous. Implements fmt.GoStringer.
+func (q *Queue[T]) GoString() string {
+	return q.format("%#v")
+}
+
+// Fingerprint returns a string key identifying the queue's contents, for use as a
+// map or Memo key. The fingerprint is the GoString form, so two queues with the same
+// elements in the same order (front to rear) have equal fingerprints regardless of capacity
+// or where their circular buffers start. Elements should have a deterministic %#v form.
+// Time complexity: O(n)
+func (q *Queue[T]) Fingerprint() string {
+	return q.GoString()
+}
+
+// format renders the queue from front to rear using verb for each element.
+func (q *Queue[T]) format(verb string) string {
 	if q.size == 0 {
 		return "Queue[]"
 	}
@@ -195,14 +447,16 @@ func (q *Queue[T]) String() string {
 			sb.WriteString(", ")
 		}
 		index := (q.front + i) % len(q.items)
-		sb.WriteString(fmt.Sprintf("%v", q.items[index]))
+		sb.WriteString(fmt.Sprintf(verb, q.items[index]))
 	}
 
 	sb.WriteString("] (front -> rear)")
 	return sb.String()
 }
 
-// Clone creates a deep copy of the queue.
+// Clone creates a copy of the queue with its own buffer.
+// The copy is shallow: elements are copied by assignment, so pointer elements share
+// their pointees with the original. Use CloneWith to copy elements deeply.
 // Time complexity: O(n)
 func (q *Queue[T]) Clone() *Queue[T] {
 	clone := NewQueueWithCapacity[T](len(q.items))
@@ -215,11 +469,64 @@ func (q *Queue[T]) Clone() *Queue[T] {
 	return clone
 }
 
+// CloneWith creates a copy of the queue whose elements are produced by copyElem,
+// preserving FIFO order. Pass a function that duplicates each element to deep-copy
+// pointer or reference elements.
+// Time complexity: O(n) calls to copyElem
+func (q *Queue[T]) CloneWith(copyElem func(T) T) *Queue[T] {
+	clone := NewQueueWithCapacity[T](len(q.items))
+
+	for i := 0; i < q.size; i++ {
+		index := (q.front + i) % len(q.items)
+		clone.Enqueue(copyElem(q.items[index]))
+	}
+
+	return clone
+}
+
+// CloneMap creates a copy of the queue with f applied to each element.
+// CloneMap is equivalent to CloneWith(f).
+// Time complexity: O(n) calls to f
+func (q *Queue[T]) CloneMap(f func(T) T) *Queue[T] {
+	return q.CloneWith(f)
+}
+
+// Snapshot returns an independent copy of the queue's current contents, so a reader
+// can keep a consistent view while the original continues to change.
+// The snapshot always copies the elements in full, in one pass sized to the current
+// length rather than the original's capacity; there is no copy-on-write sharing.
+// Like Clone the copy is shallow, and it does not carry over stats or an OnChange callback.
+// Time complexity: O(n)
+func (q *Queue[T]) Snapshot() *Queue[T] {
+	return FromSliceQueue(q.ToSlice())
+}
+
 // Capacity returns the current capacity of the underlying slice.
 func (q *Queue[T]) Capacity() int {
 	return len(q.items)
 }
 
+// Grow ensures the queue has room for at least n more elements without resizing.
+// If the buffer is too small it is reallocated once, to exactly Size()+n slots.
+// A non-positive n is a no-op.
+// Time complexity: O(n) when reallocating, otherwise O(1)
+func (q *Queue[T]) Grow(n int) {
+	required := q.size + n
+	if n <= 0 || required <= len(q.items) {
+		return
+	}
+
+	newItems := make([]T, required)
+	for i := 0; i < q.size; i++ {
+		index := (q.front + i) % len(q.items)
+		newItems[i] = q.items[index]
+	}
+
+	q.items = newItems
+	q.front = 0
+	q.rear = q.size
+}
+
 // MultiEnqueue adds multiple elements to the rear of the queue.
 // Time complexity: O(n) where n is the number of elements
 func (q *Queue[T]) MultiEnqueue(values ...T) {
@@ -228,17 +535,28 @@ func (q *Queue[T]) MultiEnqueue(values ...T) {
 	}
 }
 
+// EnqueueSeq adds every element yielded by seq to the rear of the queue, in order.
+// The length of seq is unknown up front, so the buffer grows with the usual amortized resizing.
+// Time complexity: O(n) amortized where n is the number of elements yielded
+func (q *Queue[T]) EnqueueSeq(seq iter.Seq[T]) {
+	for value := range seq {
+		q.Enqueue(value)
+	}
+}
+
 // MultiDequeue removes n elements from the front of the queue.
 // Returns the elements in the order they were dequeued.
 // Returns an error if there aren't enough elements.
+// Instead of shrinking step by step as Dequeue would, the buffer is shrunk at most once
+// at the end, to twice the remaining size but never below DefaultInitialCapacity.
 // Time complexity: O(n)
 func (q *Queue[T]) MultiDequeue(n int) ([]T, error) {
 	if n < 0 {
-		return nil, fmt.Errorf("cannot dequeue negative number of elements: %d", n)
+		return nil, fmt.Errorf("%w: cannot dequeue negative number of elements: %d", ErrInvalidArgument, n)
 	}
 
 	if n > q.size {
-		return nil, fmt.Errorf("cannot dequeue %d elements from queue of size %d", n, q.size)
+		return nil, fmt.Errorf("%w: cannot dequeue %d elements from queue of size %d", ErrInsufficientElements, n, q.size)
 	}
 
 	if n == 0 {
@@ -246,24 +564,58 @@ func (q *Queue[T]) MultiDequeue(n int) ([]T, error) {
 	}
 
 	result := make([]T, n)
+	var zero T
 	for i := 0; i < n; i++ {
-		value, _ := q.Dequeue()
-		result[i] = value
+		result[i] = q.items[q.front]
+		q.items[q.front] = zero // Clear the reference for GC
+		q.front = (q.front + 1) % len(q.items)
 	}
+	q.size -= n
 
+	if q.stats != nil {
+		q.stats.TotalDequeued += n
+	}
+
+	if q.onChange != nil {
+		for _, value := range result {
+			q.onChange("dequeue", value)
+		}
+	}
+
+	q.compact()
 	return result, nil
 }
 
+// compact shrinks the buffer in a single reallocation when the queue uses a quarter
+// of its capacity or less. The new capacity is twice the size, leaving room to grow,
+// but never less than DefaultInitialCapacity. Otherwise it does nothing.
+// Time complexity: O(n) when reallocating, otherwise O(1)
+func (q *Queue[T]) compact() {
+	if q.size > len(q.items)/ShrinkFactor || len(q.items) <= DefaultInitialCapacity {
+		return
+	}
+
+	newItems := make([]T, max(DefaultInitialCapacity, q.size*GrowthFactor))
+	for i := 0; i < q.size; i++ {
+		index := (q.front + i) % len(q.items)
+		newItems[i] = q.items[index]
+	}
+
+	q.items = newItems
+	q.front = 0
+	q.rear = q.size % len(q.items)
+}
+
 // PeekN returns the front n elements without removing them.
 // Returns an error if there aren't enough elements.
 // Time complexity: O(n)
 func (q *Queue[T]) PeekN(n int) ([]T, error) {
 	if n < 0 {
-		return nil, fmt.Errorf("cannot peek negative number of elements: %d", n)
+		return nil, fmt.Errorf("%w: cannot peek negative number of elements: %d", ErrInvalidArgument, n)
 	}
 
 	if n > q.size {
-		return nil, fmt.Errorf("cannot peek %d elements from queue of size %d", n, q.size)
+		return nil, fmt.Errorf("%w: cannot peek %d elements from queue of size %d", ErrInsufficientElements, n, q.size)
 	}
 
 	if n == 0 {
@@ -279,6 +631,21 @@ func (q *Queue[T]) PeekN(n int) ([]T, error) {
 	return result, nil
 }
 
+// PeekNInto copies up to len(dst) front elements into dst without removing them.
+// Returns the number of elements copied, which is min(len(dst), Size()).
+// This is an allocation-free alternative to PeekN.
+// Time complexity: O(k) where k is the number of elements copied
+func (q *Queue[T]) PeekNInto(dst []T) int {
+	n := min(len(dst), q.size)
+
+	for i := 0; i < n; i++ {
+		index := (q.front + i) % len(q.items)
+		dst[i] = q.items[index]
+	}
+
+	return n
+}
+
 // Reverse reverses the order of elements in the queue.
 // The front becomes the rear and vice versa.
 // Time complexity: O(n)
@@ -287,19 +654,28 @@ func (q *Queue[T]) Reverse() {
 		return
 	}
 
-	// Convert to slice, reverse it, then rebuild queue
-	slice := q.ToSlice()
-
-	// Reverse the slice
-	for i, j := 0, len(slice)-1; i < j; i, j = i+1, j-1 {
-		slice[i], slice[j] = slice[j], slice[i]
+	// Swap elements from both ends moving inward
+	for i, j := 0, q.size-1; i < j; i, j = i+1, j-1 {
+		frontIndex := (q.front + i) % len(q.items)
+		backIndex := (q.front + j) % len(q.items)
+		q.items[frontIndex], q.items[backIndex] = q.items[backIndex], q.items[frontIndex]
 	}
+}
 
-	// Clear and rebuild
-	q.Clear()
-	for _, value := range slice {
-		q.Enqueue(value)
-	}
+// Sort sorts the elements of the queue so that the front is the least element per less.
+// The sort is stable: equal elements keep their relative FIFO order.
+// The buffer is rebuilt with the front at index 0.
+// Time complexity: O(n log n)
+func (q *Queue[T]) Sort(less func(a, b T) bool) {
+	slice := q.ToSlice()
+	sort.SliceStable(slice, func(i, j int) bool {
+		return less(slice[i], slice[j])
+	})
+
+	clear(q.items) // Drop stale references outside the rebuilt range
+	copy(q.items, slice)
+	q.front = 0
+	q.rear = q.size % len(q.items)
 }
 
 // resize doubles the capacity when full, halves when 1/4 full
@@ -331,11 +707,147 @@ func (q *Queue[T]) resize() {
 // Time complexity: O(n)
 func (q *Queue[T]) DrainTo() []T {
 	result := q.ToSlice()
+
+	if q.stats != nil {
+		q.stats.TotalDequeued += q.size
+	}
+
 	q.Clear()
 	return result
 }
 
+// Chunk removes all elements from the queue and returns them in FIFO order,
+// split into batches of at most size elements. The last batch may be smaller.
+// Returns an error if size is not positive; the queue is left untouched in that case.
+// The queue becomes empty after a successful call.
+// Time complexity: O(n)
+func (q *Queue[T]) Chunk(size int) ([][]T, error) {
+	if size <= 0 {
+		return nil, fmt.Errorf("%w: chunk size must be positive: %d", ErrInvalidArgument, size)
+	}
+
+	return ChunkSlice(q.DrainTo(), size), nil
+}
+
+// MoveToStack removes all elements from the queue and returns them as a stack.
+// The front of the queue becomes the bottom of the stack and the rear becomes the top,
+// so popping the stack yields the elements in reverse FIFO order.
+// The queue becomes empty after this operation.
+// Time complexity: O(n)
+func (q *Queue[T]) MoveToStack() *Stack[T] {
+	return &Stack[T]{
+		items: q.DrainTo(),
+	}
+}
+
 // Peek returns the front element (alias for Front for consistency with other collections).
 func (q *Queue[T]) Peek() (T, error) {
 	return q.Front()
 }
+
+// PeekLast returns the rear element (alias for Rear for consistency with other collections).
+func (q *Queue[T]) PeekLast() (T, error) {
+	return q.Rear()
+}
+
+// PeekBack returns the element offset positions from the rear without removing it.
+// An offset of 0 returns the rear element, size-1 returns the front element.
+// Returns an error if the offset is out of bounds.
+// Time complexity: O(1)
+func (q *Queue[T]) PeekBack(offset int) (T, error) {
+	var zero T
+
+	if offset < 0 || offset >= q.size {
+		return zero, fmt.Errorf("%w: offset %d for queue of size %d", ErrIndexOutOfBounds, offset, q.size)
+	}
+
+	index := (q.front + q.size - 1 - offset) % len(q.items)
+	return q.items[index], nil
+}
+
+// Stats returns a snapshot of the queue's throughput metrics.
+// Returns the zero value if the queue was not created with NewQueueWithStats.
+func (q *Queue[T]) Stats() QueueStats {
+	if q.stats == nil {
+		return QueueStats{}
+	}
+	return *q.stats
+}
+
+// OnChange registers f to be called with the operation name ("enqueue", "putfront"
+// or "dequeue") and the element involved whenever the queue gains or loses an element.
+// Enqueue, PutFront, Dequeue, MultiEnqueue, MultiDequeue and Poll fire it once per
+// element. Bulk removals such as Clear, DrainTo, RetainIf and ByteQueue.WriteTo do not.
+// Only one callback is kept: a later call replaces it, and passing nil removes it.
+func (q *Queue[T]) OnChange(f func(op string, value T)) {
+	q.onChange = f
+}
+
+// BinarySearchQueue searches a queue sorted front-to-rear in ascending order per cmp.
+// It behaves exactly like BinarySearch for Deque.
+// Time complexity: O(log n)
+func BinarySearchQueue[T any](q *Queue[T], target T, cmp func(a, b T) int) (int, bool) {
+	lo, hi := 0, q.size
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if cmp(q.items[(q.front+mid)%len(q.items)], target) < 0 {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+
+	found := lo < q.size && cmp(q.items[(q.front+lo)%len(q.items)], target) == 0
+	return lo, found
+}
+
+// Interleave returns a new queue that alternates elements from the fronts of a and b,
+// starting with a, then appends the remainder of whichever queue is longer.
+// Neither input is modified.
+// Time complexity: O(n + m)
+func Interleave[T any](a, b *Queue[T]) *Queue[T] {
+	result := NewQueueWithCapacity[T](a.size + b.size)
+
+	for i := 0; i < max(a.size, b.size); i++ {
+		if i < a.size {
+			result.Enqueue(a.items[(a.front+i)%len(a.items)])
+		}
+		if i < b.size {
+			result.Enqueue(b.items[(b.front+i)%len(b.items)])
+		}
+	}
+
+	return result
+}
+
+// MovingAverage returns the average of every full window of consecutive values in data,
+// in order. A Queue holds the current window, so the running sum is updated in O(1) per
+// value by adding the newest value and subtracting the one that leaves the window.
+// Returns an empty slice if data has fewer than window values, or an error wrapping
+// ErrInvalidArgument if window is not positive.
+// Time complexity: O(n)
+func MovingAverage(data []float64, window int) ([]float64, error) {
+	if window <= 0 {
+		return nil, fmt.Errorf("%w: window size must be positive: %d", ErrInvalidArgument, window)
+	}
+
+	averages := make([]float64, 0, max(len(data)-window+1, 0))
+	current := NewQueueWithCapacity[float64](window)
+	sum := 0.0
+
+	for _, value := range data {
+		current.Enqueue(value)
+		sum += value
+
+		if current.Size() > window {
+			oldest, _ := current.Dequeue()
+			sum -= oldest
+		}
+
+		if current.Size() == window {
+			averages = append(averages, sum/float64(window))
+		}
+	}
+
+	return averages, nil
+}
@@ -1,10 +1,33 @@
 package collections
 
 import (
+	"errors"
+	"fmt"
 	"reflect"
+	"slices"
+	"strings"
 	"testing"
 )
 
+// newWrappedQueue returns a queue holding values whose front sits at the last
+// slot of the buffer, so any two or more values wrap around the end.
+func newWrappedQueue(t *testing.T, values ...int) *Queue[int] {
+	t.Helper()
+
+	q := NewQueueWithCapacity[int](max(len(values), 4))
+	for range len(q.items) - 1 {
+		// One at a time, so the emptying dequeue never triggers a shrink
+		q.Enqueue(0)
+		q.Dequeue()
+	}
+	q.MultiEnqueue(values...)
+
+	if len(values) > 1 && q.front+q.size <= len(q.items) {
+		t.Fatalf("expected a wrapped buffer, got front %d of %d", q.front, len(q.items))
+	}
+	return q
+}
+
 func TestNewQueue(t *testing.T) {
 	q := NewQueue[int]()
 
@@ -62,6 +85,30 @@ func TestFromSliceQueue(t *testing.T) {
 	}
 }
 
+func TestQueueOf(t *testing.T) {
+	// Construction is covered by TestListOf; this checks the first value is the front
+	if front, err := QueueOf(1, 2, 3).Peek(); err != nil || front != 1 {
+		t.Errorf("expected front 1, got %d, error=%v", front, err)
+	}
+}
+
+func TestFromSeqQueue(t *testing.T) {
+	// Sequence handling is covered by TestFromSeq; this grows past the initial buffer
+	values := make([]int, 2*DefaultInitialCapacity+1)
+	for i := range values {
+		values[i] = i
+	}
+	c := FromSeqQueue(slices.Values(values))
+
+	if !reflect.DeepEqual(c.ToSlice(), values) {
+		t.Errorf("expected %v, got %v", values, c.ToSlice())
+	}
+
+	if value, err := c.Dequeue(); err != nil || value != 0 {
+		t.Errorf("expected 0 from Dequeue, got %d, error=%v", value, err)
+	}
+}
+
 func TestEnqueue(t *testing.T) {
 	q := NewQueue[int]()
 
@@ -95,6 +142,146 @@ func TestEnqueue(t *testing.T) {
 	}
 }
 
+func TestEnqueueIfAbsent(t *testing.T) {
+	q := NewQueue[string]()
+
+	for _, v := range []string{"a", "b", "c"} {
+		if !q.EnqueueIfAbsent(v) {
+			t.Errorf("expected %q to be added", v)
+		}
+	}
+
+	for _, v := range []string{"a", "c"} {
+		if q.EnqueueIfAbsent(v) {
+			t.Errorf("expected duplicate %q to be rejected", v)
+		}
+	}
+
+	expected := []string{"a", "b", "c"}
+	if !reflect.DeepEqual(q.ToSlice(), expected) {
+		t.Errorf("expected %v, got %v", expected, q.ToSlice())
+	}
+}
+
+func TestQueuePutFront(t *testing.T) {
+	t.Run("jumps the line", func(t *testing.T) {
+		q := QueueOf(1, 2, 3)
+		q.PutFront(0)
+
+		if value, err := q.Dequeue(); err != nil || value != 0 {
+			t.Errorf("expected 0 first, got %d, error=%v", value, err)
+		}
+
+		if expected := []int{1, 2, 3}; !reflect.DeepEqual(q.ToSlice(), expected) {
+			t.Errorf("expected %v, got %v", expected, q.ToSlice())
+		}
+	})
+
+	t.Run("empty queue", func(t *testing.T) {
+		q := NewQueue[int]()
+		q.PutFront(7)
+		q.Enqueue(8)
+
+		if expected := []int{7, 8}; !reflect.DeepEqual(q.ToSlice(), expected) {
+			t.Errorf("expected %v, got %v", expected, q.ToSlice())
+		}
+	})
+
+	t.Run("across resize", func(t *testing.T) {
+		q := NewQueueWithCapacity[int](4)
+		q.MultiEnqueue(1, 2, 3, 4)
+		q.PutFront(0) // Full, so this grows the buffer
+		q.PutFront(-1)
+		q.Enqueue(5)
+
+		if q.Capacity() <= 4 {
+			t.Errorf("expected capacity to grow beyond 4, got %d", q.Capacity())
+		}
+
+		if expected := []int{-1, 0, 1, 2, 3, 4, 5}; !reflect.DeepEqual(q.ToSlice(), expected) {
+			t.Errorf("expected %v, got %v", expected, q.ToSlice())
+		}
+	})
+
+	t.Run("stats and callback", func(t *testing.T) {
+		q := NewQueueWithStats[int]()
+		var ops []string
+		q.OnChange(func(op string, value int) { ops = append(ops, op) })
+
+		q.Enqueue(1)
+		q.PutFront(2)
+
+		if stats := q.Stats(); stats.TotalEnqueued != 2 || stats.HighWaterMark != 2 {
+			t.Errorf("expected 2 enqueued with high-water mark 2, got %+v", stats)
+		}
+
+		if expected := []string{"enqueue", "putfront"}; !reflect.DeepEqual(ops, expected) {
+			t.Errorf("expected %v, got %v", expected, ops)
+		}
+	})
+}
+
+func TestQueueRetainIf(t *testing.T) {
+	isEven := func(v int) bool { return v%2 == 0 }
+
+	// wrapped builds a queue holding values whose contents wrap around the buffer end
+	wrapped := func(values ...int) *Queue[int] {
+		q := NewQueueWithCapacity[int](8)
+		q.MultiEnqueue(0, 0, 0, 0, 0, 0)
+		placeholders := 6
+		for _, v := range values {
+			q.Enqueue(v)
+			if placeholders > 0 {
+				q.Dequeue()
+				placeholders--
+			}
+		}
+		for ; placeholders > 0; placeholders-- {
+			q.Dequeue()
+		}
+		return q
+	}
+
+	tests := []struct {
+		name            string
+		values          []int
+		pred            func(int) bool
+		expected        []int
+		expectedRemoved int
+	}{
+		{"evens", []int{1, 2, 3, 4, 5, 6, 7}, isEven, []int{2, 4, 6}, 4},
+		{"retain all", []int{2, 4, 6}, isEven, []int{2, 4, 6}, 0},
+		{"retain none", []int{1, 3, 5}, isEven, []int{}, 3},
+		{"empty", []int{}, isEven, []int{}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := wrapped(tt.values...)
+			removed := q.RetainIf(tt.pred)
+
+			if removed != tt.expectedRemoved {
+				t.Errorf("expected %d removed, got %d", tt.expectedRemoved, removed)
+			}
+
+			if result := q.ToSlice(); !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+
+			// Front and rear must stay consistent for later operations
+			q.Enqueue(100)
+			expected := append(slices.Clone(tt.expected), 100)
+			if result := q.ToSlice(); !reflect.DeepEqual(result, expected) {
+				t.Errorf("expected %v after enqueue, got %v", expected, result)
+			}
+
+			if front, _ := q.Front(); front != expected[0] {
+				t.Errorf("expected front %d, got %d", expected[0], front)
+			}
+		})
+	}
+}
+
 func TestDequeue(t *testing.T) {
 	q := FromSliceQueue([]int{1, 2, 3})
 
@@ -129,6 +316,25 @@ func TestDequeue(t *testing.T) {
 	}
 }
 
+func TestPoll(t *testing.T) {
+	q := FromSliceQueue([]int{1, 2})
+
+	value, ok := q.Poll()
+	if !ok || value != 1 {
+		t.Errorf("expected value=1, ok=true, got %d, %t", value, ok)
+	}
+
+	value, ok = q.Poll()
+	if !ok || value != 2 {
+		t.Errorf("expected value=2, ok=true, got %d, %t", value, ok)
+	}
+
+	value, ok = q.Poll()
+	if ok || value != 0 {
+		t.Errorf("expected zero value and ok=false, got %d, %t", value, ok)
+	}
+}
+
 func TestFrontRear(t *testing.T) {
 	// Test empty queue
 	q := NewQueue[int]()
@@ -231,6 +437,22 @@ func TestMultiEnqueue(t *testing.T) {
 	}
 }
 
+func TestEnqueueSeq(t *testing.T) {
+	q := FromSliceQueue([]int{1})
+	q.EnqueueSeq(slices.Values([]int{2, 3, 4, 5, 6}))
+
+	expected := []int{1, 2, 3, 4, 5, 6}
+	if !reflect.DeepEqual(q.ToSlice(), expected) {
+		t.Errorf("expected %v, got %v", expected, q.ToSlice())
+	}
+
+	// Custom generator that yields nothing
+	q.EnqueueSeq(func(yield func(int) bool) {})
+	if q.Size() != len(expected) {
+		t.Errorf("expected size %d, got %d", len(expected), q.Size())
+	}
+}
+
 func TestMultiDequeue(t *testing.T) {
 	q := FromSliceQueue([]int{1, 2, 3, 4, 5})
 
@@ -320,6 +542,120 @@ func TestQueuePeekN(t *testing.T) {
 	}
 }
 
+func TestQueuePeekBack(t *testing.T) {
+	q := newWrappedQueue(t, 10, 20, 30, 40)
+
+	tests := []struct {
+		name      string
+		offset    int
+		expected  int
+		expectErr bool
+	}{
+		{"offset 0 is rear", 0, 40, false},
+		{"middle offset", 2, 20, false},
+		{"oldest element", 3, 10, false},
+		{"offset too large", 4, 0, true},
+		{"negative offset", -1, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := q.PeekBack(tt.offset)
+
+			if tt.expectErr {
+				if err == nil {
+					t.Error("expected error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+
+			if result != tt.expected {
+				t.Errorf("expected %d, got %d", tt.expected, result)
+			}
+
+			// Size should remain unchanged
+			if q.Size() != 4 {
+				t.Errorf("expected size 4, got %d", q.Size())
+			}
+		})
+	}
+
+	last, err := q.PeekLast()
+	if err != nil || last != 40 {
+		t.Errorf("expected PeekLast=40, got %d, error=%v", last, err)
+	}
+}
+
+func TestQueuePeekNInto(t *testing.T) {
+	q := newWrappedQueue(t, 1, 2, 3, 4)
+
+	tests := []struct {
+		name     string
+		bufSize  int
+		expected []int
+	}{
+		{"too small", 2, []int{1, 2}},
+		{"exact", 4, []int{1, 2, 3, 4}},
+		{"oversized", 6, []int{1, 2, 3, 4, -1, -1}},
+		{"empty buffer", 0, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dst := make([]int, tt.bufSize)
+			for i := range dst {
+				dst[i] = -1
+			}
+
+			n := q.PeekNInto(dst)
+
+			expectedCount := min(tt.bufSize, 4)
+			if n != expectedCount {
+				t.Errorf("expected %d copied, got %d", expectedCount, n)
+			}
+
+			if !reflect.DeepEqual(dst, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, dst)
+			}
+
+			// Queue must not be mutated
+			if !reflect.DeepEqual(q.ToSlice(), []int{1, 2, 3, 4}) {
+				t.Errorf("expected queue unchanged, got %v", q.ToSlice())
+			}
+		})
+	}
+}
+
+func TestBinarySearchQueue(t *testing.T) {
+	q := FromSliceQueue([]string{"apple", "banana", "cherry", "grape"})
+
+	tests := []struct {
+		name          string
+		target        string
+		expectedIndex int
+		expectedFound bool
+	}{
+		{"present", "cherry", 2, true},
+		{"absent", "date", 3, false},
+		{"insertion at end", "kiwi", 4, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			index, found := BinarySearchQueue(q, tt.target, strings.Compare)
+
+			if index != tt.expectedIndex || found != tt.expectedFound {
+				t.Errorf("expected (%d, %t), got (%d, %t)", tt.expectedIndex, tt.expectedFound, index, found)
+			}
+		})
+	}
+}
+
 func TestQueueContains(t *testing.T) {
 	q := FromSliceQueue([]int{13, 23, 33})
 
@@ -362,6 +698,34 @@ func TestQueueClone(t *testing.T) {
 	}
 }
 
+func TestQueueCloneWith(t *testing.T) {
+	q := FromSliceQueue([][]int{{1, 2}, {3}})
+	clone := q.CloneWith(slices.Clone[[]int])
+
+	front, _ := clone.Front()
+	front[0] = 100
+
+	original, _ := q.Front()
+	if original[0] != 1 {
+		t.Errorf("expected original element unchanged, got %v", original)
+	}
+
+	expected := [][]int{{100, 2}, {3}}
+	if !reflect.DeepEqual(clone.ToSlice(), expected) {
+		t.Errorf("expected %v, got %v", expected, clone.ToSlice())
+	}
+}
+
+func TestQueueCloneMap(t *testing.T) {
+	double := func(v int) int { return v * 2 }
+	c := QueueOf(1, 2, 3)
+
+	result, expected := c.CloneMap(double).ToSlice(), c.CloneWith(double).ToSlice()
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
 func TestQueueReverse(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -387,6 +751,125 @@ func TestQueueReverse(t *testing.T) {
 	}
 }
 
+func TestQueueToReversedSlice(t *testing.T) {
+	wrapped := newWrappedQueue(t, 1, 2, 3, 4)
+
+	tests := []struct {
+		name string
+		q    *Queue[int]
+	}{
+		{"empty queue", NewQueue[int]()},
+		{"single element", FromSliceQueue([]int{1})},
+		{"multiple elements", FromSliceQueue([]int{1, 2, 3, 4})},
+		{"wrapped buffer", wrapped},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := tt.q.ToSlice()
+			result := tt.q.ToReversedSlice()
+
+			reference := tt.q.Clone()
+			reference.Reverse()
+
+			if !reflect.DeepEqual(result, reference.ToSlice()) {
+				t.Errorf("expected %v, got %v", reference.ToSlice(), result)
+			}
+
+			// Original must be unchanged
+			if !reflect.DeepEqual(tt.q.ToSlice(), before) {
+				t.Errorf("expected queue unchanged %v, got %v", before, tt.q.ToSlice())
+			}
+		})
+	}
+}
+
+func TestQueueSort(t *testing.T) {
+	ascending := func(a, b int) bool { return a < b }
+	descending := func(a, b int) bool { return a > b }
+
+	tests := []struct {
+		name     string
+		initial  []int
+		less     func(a, b int) bool
+		expected []int
+	}{
+		{"ascending", []int{5, 2, 8, 1, 9, 3}, ascending, []int{1, 2, 3, 5, 8, 9}},
+		{"descending", []int{5, 2, 8, 1, 9, 3}, descending, []int{9, 8, 5, 3, 2, 1}},
+		{"already sorted", []int{1, 2, 3, 4}, ascending, []int{1, 2, 3, 4}},
+		{"empty queue", []int{}, ascending, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := newWrappedQueue(t, tt.initial...)
+
+			q.Sort(tt.less)
+
+			if !reflect.DeepEqual(q.ToSlice(), tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, q.ToSlice())
+			}
+
+			if q.front != 0 || q.rear != q.size%len(q.items) {
+				t.Errorf("expected front=0 and rear=%d, got front=%d rear=%d", q.size%len(q.items), q.front, q.rear)
+			}
+
+			// Buffer must remain consistent for later operations
+			q.Enqueue(100)
+			if rear, _ := q.Rear(); rear != 100 {
+				t.Errorf("expected rear=100 after enqueue, got %d", rear)
+			}
+		})
+	}
+}
+
+func TestQueueSortStable(t *testing.T) {
+	type record struct {
+		key   int
+		label string
+	}
+
+	q := FromSliceQueue([]record{{2, "a"}, {1, "b"}, {2, "c"}, {1, "d"}})
+	q.Sort(func(a, b record) bool { return a.key < b.key })
+
+	expected := []record{{1, "b"}, {1, "d"}, {2, "a"}, {2, "c"}}
+	if !reflect.DeepEqual(q.ToSlice(), expected) {
+		t.Errorf("expected %v, got %v", expected, q.ToSlice())
+	}
+}
+
+func TestFromSliceQueueFullBuffer(t *testing.T) {
+	q := FromSliceQueue([]int{1, 2, 3, 4})
+	q.Dequeue()
+	q.Enqueue(5)
+
+	expected := []int{2, 3, 4, 5}
+	if !reflect.DeepEqual(q.ToSlice(), expected) {
+		t.Errorf("expected %v, got %v", expected, q.ToSlice())
+	}
+}
+
+func TestQueueAll(t *testing.T) {
+	q := newWrappedQueue(t, 1, 2, 3, 4)
+
+	result := slices.Collect(q.All())
+	if !reflect.DeepEqual(result, []int{1, 2, 3, 4}) {
+		t.Errorf("expected [1 2 3 4], got %v", result)
+	}
+
+	// Early break stops iteration
+	var seen []int
+	for v := range q.All() {
+		if v == 3 {
+			break
+		}
+		seen = append(seen, v)
+	}
+	if !reflect.DeepEqual(seen, []int{1, 2}) {
+		t.Errorf("expected [1 2], got %v", seen)
+	}
+}
+
 func TestDrainTo(t *testing.T) {
 	q := FromSliceQueue([]int{1, 2, 3, 4})
 
@@ -402,6 +885,33 @@ func TestDrainTo(t *testing.T) {
 	}
 }
 
+func TestMoveToStack(t *testing.T) {
+	q := FromSliceQueue([]int{1, 2, 3})
+	s := q.MoveToStack()
+
+	if !q.IsEmpty() {
+		t.Error("expected queue to be drained")
+	}
+
+	// Front ends at the bottom, so pop order is reversed
+	expected := []int{3, 2, 1}
+	result := make([]int, 0, len(expected))
+	for !s.IsEmpty() {
+		v, _ := s.Pop()
+		result = append(result, v)
+	}
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected pop order %v, got %v", expected, result)
+	}
+
+	// Source stays usable after the move
+	q.Enqueue(4)
+	if front, _ := q.Front(); front != 4 {
+		t.Errorf("expected front=4, got %d", front)
+	}
+}
+
 func TestQueueString(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -425,6 +935,39 @@ func TestQueueString(t *testing.T) {
 	}
 }
 
+func TestQueueGoString(t *testing.T) {
+	tests := []struct {
+		name     string
+		initial  []string
+		expected string
+	}{
+		{"empty queue", []string{}, "Queue[]"},
+		{"comma inside element", []string{"a, b"}, `Queue["a, b"] (front -> rear)`},
+		{"brackets inside elements", []string{"[x]", "y]"}, `Queue["[x]", "y]"] (front -> rear)`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := FromSliceQueue(tt.initial)
+
+			if result := q.GoString(); result != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, result)
+			}
+
+			// %#v must pick up the GoStringer implementation
+			if result := fmt.Sprintf("%#v", q); result != tt.expected {
+				t.Errorf("expected %s via %%#v, got %s", tt.expected, result)
+			}
+		})
+	}
+
+	// String stays unquoted for readability
+	q := FromSliceQueue([]string{"a, b"})
+	if q.String() != "Queue[a, b] (front -> rear)" {
+		t.Errorf("expected unquoted String, got %s", q.String())
+	}
+}
+
 func TestQueueFIFOBehavior(t *testing.T) {
 	q := NewQueue[int]()
 
@@ -477,6 +1020,379 @@ func TestResizing(t *testing.T) {
 	}
 }
 
+func TestQueueStats(t *testing.T) {
+	q := NewQueueWithStats[int]()
+
+	// Producer bursts ahead of the consumer
+	q.MultiEnqueue(1, 2, 3, 4, 5)
+	q.MultiDequeue(3)
+	q.MultiEnqueue(6, 7, 8, 9)
+	q.Poll()
+	q.Reverse() // Reordering is not throughput
+	q.Enqueue(10)
+
+	expected := QueueStats{HighWaterMark: 6, TotalEnqueued: 10, TotalDequeued: 4}
+	if stats := q.Stats(); stats != expected {
+		t.Errorf("expected %+v, got %+v", expected, stats)
+	}
+
+	// Draining counts every remaining element as dequeued
+	q.DrainTo()
+	expected.TotalDequeued = 10
+	if stats := q.Stats(); stats != expected {
+		t.Errorf("expected %+v after drain, got %+v", expected, stats)
+	}
+
+	// Plain queues do not track anything
+	plain := NewQueue[int]()
+	plain.MultiEnqueue(1, 2, 3)
+	if stats := plain.Stats(); stats != (QueueStats{}) {
+		t.Errorf("expected zero stats for plain queue, got %+v", stats)
+	}
+}
+
+func TestQueueChunk(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		size     int
+		expected [][]int
+	}{
+		{"uneven", []int{1, 2, 3, 4, 5, 6, 7}, 3, [][]int{{1, 2, 3}, {4, 5, 6}, {7}}},
+		{"even", []int{1, 2, 3, 4}, 2, [][]int{{1, 2}, {3, 4}}},
+		{"size larger than queue", []int{1, 2}, 5, [][]int{{1, 2}}},
+		{"empty", []int{}, 3, [][]int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := FromSliceQueue(tt.input)
+			result, err := q.Chunk(tt.size)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+
+			if !q.IsEmpty() {
+				t.Errorf("expected drained queue, got size %d", q.Size())
+			}
+		})
+	}
+
+	q := FromSliceQueue([]int{1, 2, 3})
+	if _, err := q.Chunk(0); err == nil {
+		t.Error("expected error for chunk size 0")
+	}
+	if q.Size() != 3 {
+		t.Errorf("expected queue untouched after invalid chunk size, got size %d", q.Size())
+	}
+}
+
+func TestQueueOnChange(t *testing.T) {
+	q := NewQueue[int]()
+	var events []string
+
+	q.OnChange(func(op string, value int) {
+		events = append(events, fmt.Sprintf("%s %d", op, value))
+	})
+
+	q.Enqueue(1)
+	q.MultiEnqueue(2, 3)
+	q.Dequeue()
+	q.Poll()
+	q.Front() // Reads do not fire
+
+	expected := []string{"enqueue 1", "enqueue 2", "enqueue 3", "dequeue 1", "dequeue 2"}
+	if !reflect.DeepEqual(events, expected) {
+		t.Errorf("expected %v, got %v", expected, events)
+	}
+
+	q.OnChange(nil)
+	q.Enqueue(4)
+	if len(events) != len(expected) {
+		t.Errorf("expected no events after removing callback, got %v", events[len(expected):])
+	}
+}
+
+func TestInterleave(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        []int
+		b        []int
+		expected []int
+	}{
+		{"equal length", []int{1, 3, 5}, []int{2, 4, 6}, []int{1, 2, 3, 4, 5, 6}},
+		{"longer a", []int{1, 3, 5, 7, 8}, []int{2, 4}, []int{1, 2, 3, 4, 5, 7, 8}},
+		{"longer b", []int{1}, []int{2, 3, 4}, []int{1, 2, 3, 4}},
+		{"empty a", []int{}, []int{1, 2}, []int{1, 2}},
+		{"both empty", []int{}, []int{}, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := FromSliceQueue(tt.a), FromSliceQueue(tt.b)
+			result := Interleave(a, b)
+
+			if !reflect.DeepEqual(result.ToSlice(), tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result.ToSlice())
+			}
+
+			if !reflect.DeepEqual(a.ToSlice(), tt.a) || !reflect.DeepEqual(b.ToSlice(), tt.b) {
+				t.Errorf("expected inputs unchanged, got %v and %v", a.ToSlice(), b.ToSlice())
+			}
+		})
+	}
+}
+
+func TestMovingAverage(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     []float64
+		window   int
+		expected []float64
+	}{
+		{"window of three", []float64{1, 3, 5, 7, 9, 11}, 3, []float64{3, 5, 7, 9}},
+		{"window of one", []float64{2, 4, 6}, 1, []float64{2, 4, 6}},
+		{"window equals length", []float64{1, 2, 3, 4}, 4, []float64{2.5}},
+		{"window longer than data", []float64{1, 2}, 3, []float64{}},
+		{"empty data", []float64{}, 2, []float64{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := MovingAverage(tt.data, tt.window)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+
+	for _, window := range []int{0, -1} {
+		if _, err := MovingAverage([]float64{1, 2}, window); !errors.Is(err, ErrInvalidArgument) {
+			t.Errorf("expected ErrInvalidArgument for window %d, got %v", window, err)
+		}
+	}
+}
+
+func TestQueueSnapshot(t *testing.T) {
+	q := FromSliceQueue([]int{1, 2, 3})
+	snap := q.Snapshot()
+
+	q.Enqueue(4)
+	q.Dequeue()
+	q.Reverse()
+
+	expected := []int{1, 2, 3}
+	if !reflect.DeepEqual(snap.ToSlice(), expected) {
+		t.Errorf("expected snapshot %v, got %v", expected, snap.ToSlice())
+	}
+
+	// Changes to the snapshot do not leak back either
+	snap.Clear()
+	if !reflect.DeepEqual(q.ToSlice(), []int{4, 3, 2}) {
+		t.Errorf("expected original [4 3 2], got %v", q.ToSlice())
+	}
+
+	if NewQueue[int]().Snapshot().Size() != 0 {
+		t.Error("expected empty snapshot of empty queue")
+	}
+}
+
+func TestQueueEqualAsMultiset(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        []int
+		b        []int
+		expected bool
+	}{
+		{"same order", []int{1, 2, 3}, []int{1, 2, 3}, true},
+		{"different order", []int{1, 2, 2, 3}, []int{2, 3, 1, 2}, true},
+		{"different counts", []int{1, 1, 2}, []int{1, 2, 2}, false},
+		{"different sizes", []int{1, 2}, []int{1, 2, 2}, false},
+		{"both empty", []int{}, []int{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := FromSliceQueue(tt.a), FromSliceQueue(tt.b)
+			if got := a.EqualAsMultiset(b); got != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+			if got := b.EqualAsMultiset(a); got != tt.expected {
+				t.Errorf("expected %v with arguments swapped, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestQueueGrow(t *testing.T) {
+	q := QueueOf(1, 2, 3)
+	q.Dequeue() // Offset the front so Grow must unwrap the buffer
+
+	q.Grow(1000)
+	capacity := q.Capacity()
+	if capacity < 1002 {
+		t.Fatalf("expected capacity of at least 1002, got %d", capacity)
+	}
+
+	for i := 0; i < 1000; i++ {
+		q.Enqueue(i)
+	}
+
+	if q.Capacity() != capacity {
+		t.Errorf("expected capacity to stay %d, got %d", capacity, q.Capacity())
+	}
+
+	if front, _ := q.Front(); front != 2 || q.Size() != 1002 {
+		t.Errorf("expected front 2 and size 1002, got %d and %d", front, q.Size())
+	}
+
+	// Growing within the current capacity is a no-op
+	q.Grow(0)
+	q.Grow(-5)
+	if q.Capacity() != capacity {
+		t.Errorf("expected capacity to stay %d, got %d", capacity, q.Capacity())
+	}
+}
+
+func TestQueueMapInPlace(t *testing.T) {
+	q := NewQueueWithCapacity[int](4)
+	q.MultiEnqueue(0, 1, 2, 3)
+	q.Dequeue()
+	q.Enqueue(4) // Wraps around the buffer
+	buffer := &q.items[0]
+
+	q.MapInPlace(func(v int) int { return v + 1 })
+
+	if !reflect.DeepEqual(q.ToSlice(), []int{2, 3, 4, 5}) {
+		t.Errorf("expected [2 3 4 5], got %v", q.ToSlice())
+	}
+
+	if &q.items[0] != buffer || q.Capacity() != 4 {
+		t.Error("expected the buffer to be reused")
+	}
+}
+
+func TestQueueForEachUntil(t *testing.T) {
+	c := QueueOf(1, 2, 3, 4, 5)
+	var visited []int
+
+	c.ForEachUntil(func(v int) bool {
+		visited = append(visited, v)
+		return v != 3
+	})
+
+	// Elements after the target are never passed to the callback
+	if !reflect.DeepEqual(visited, []int{1, 2, 3}) {
+		t.Errorf("expected visited %v, got %v", []int{1, 2, 3}, visited)
+	}
+
+	count := 0
+	c.ForEachUntil(func(int) bool {
+		count++
+		return true
+	})
+	if count != 5 {
+		t.Errorf("expected 5 calls without stopping, got %d", count)
+	}
+}
+
+func TestQueueMultiDequeueCompacts(t *testing.T) {
+	q := NewQueueWithStats[int]()
+	for i := 0; i < 1000; i++ {
+		q.Enqueue(i)
+	}
+
+	dequeued := 0
+	q.OnChange(func(op string, _ int) {
+		if op == "dequeue" {
+			dequeued++
+		}
+	})
+
+	if _, err := q.MultiDequeue(990); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if q.Capacity() != 20 {
+		t.Errorf("expected capacity 20 after compaction, got %d", q.Capacity())
+	}
+
+	expected := []int{990, 991, 992, 993, 994, 995, 996, 997, 998, 999}
+	if !reflect.DeepEqual(q.ToSlice(), expected) {
+		t.Errorf("expected %v, got %v", expected, q.ToSlice())
+	}
+
+	if dequeued != 990 || q.Stats().TotalDequeued != 990 {
+		t.Errorf("expected 990 dequeue events and stats, got %d and %d", dequeued, q.Stats().TotalDequeued)
+	}
+
+	// Never shrinks below the default capacity
+	q.MultiDequeue(10)
+	if q.Capacity() != DefaultInitialCapacity {
+		t.Errorf("expected capacity %d, got %d", DefaultInitialCapacity, q.Capacity())
+	}
+
+	q.Enqueue(1)
+	if front, _ := q.Front(); front != 1 || q.Size() != 1 {
+		t.Errorf("expected queue usable after compaction, got %v", q.ToSlice())
+	}
+}
+
+func TestQueueCountValue(t *testing.T) {
+	// The full value table lives in TestCountValue; this covers a wrapped buffer
+	q := newWrappedQueue(t, 1, 2, 1, 1)
+
+	if got := q.CountValue(1); got != 3 {
+		t.Errorf("expected 3 occurrences of 1, got %d", got)
+	}
+
+	if got := q.CountValue(0); got != 0 {
+		t.Errorf("expected dequeued padding to be uncounted, got %d", got)
+	}
+}
+
+func TestQueueApplyE(t *testing.T) {
+	double := func(v int) (int, error) { return v * 2, nil }
+
+	q := QueueOf(1, 2, 3, 4)
+	if err := q.ApplyE(double); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(q.ToSlice(), []int{2, 4, 6, 8}) {
+		t.Errorf("expected [2 4 6 8], got %v", q.ToSlice())
+	}
+
+	errTooBig := errors.New("too big")
+	q = QueueOf(1, 2, 3, 4)
+	err := q.ApplyE(func(v int) (int, error) {
+		if v == 3 {
+			return 0, errTooBig
+		}
+		return v * 10, nil
+	})
+
+	if !errors.Is(err, errTooBig) {
+		t.Fatalf("expected wrapped errTooBig, got %v", err)
+	}
+
+	if !strings.Contains(err.Error(), "index 2") {
+		t.Errorf("expected error to name index 2, got %q", err.Error())
+	}
+
+	// First two transformed, failing element and the rest untouched
+	if !reflect.DeepEqual(q.ToSlice(), []int{10, 20, 3, 4}) {
+		t.Errorf("expected [10 20 3 4], got %v", q.ToSlice())
+	}
+}
+
 // Benchmark tests
 func BenchmarkEnqueue(b *testing.B) {
 	q := NewQueue[int]()
@@ -499,6 +1415,26 @@ func BenchmarkDequeue(b *testing.B) {
 	}
 }
 
+func BenchmarkDequeueEmpty(b *testing.B) {
+	q := NewQueue[int]()
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		q.Dequeue()
+	}
+}
+
+func BenchmarkPollEmpty(b *testing.B) {
+	q := NewQueue[int]()
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		q.Poll()
+	}
+}
+
 func BenchmarkFront(b *testing.B) {
 	q := NewQueue[int]()
 	q.Enqueue(1)
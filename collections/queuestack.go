@@ -0,0 +1,73 @@
+package collections
+
+import "fmt"
+
+// QueueStack represents a Last-In-First-Out (LIFO) stack built from two queues.
+// This is the push-costly variant: each Push enqueues the new element onto the empty
+// helper queue, moves every existing element behind it, then swaps the two queues.
+// The main queue therefore always holds elements with the top at its front.
+// Push is O(n); Pop, Peek and Size are O(1).
+type QueueStack[T any] struct {
+	main   *Queue[T] // Holds all elements, top of the stack at the front
+	helper *Queue[T] // Always empty between operations
+}
+
+// NewQueueStack creates and returns a new empty two-queue stack.
+func NewQueueStack[T any]() *QueueStack[T] {
+	return &QueueStack[T]{
+		main:   NewQueue[T](),
+		helper: NewQueue[T](),
+	}
+}
+
+// Push adds an element to the top of the stack.
+// Time complexity: O(n)
+func (qs *QueueStack[T]) Push(value T) {
+	qs.helper.Enqueue(value)
+
+	// Move older elements behind the new one
+	for !qs.main.IsEmpty() {
+		v, _ := qs.main.Dequeue()
+		qs.helper.Enqueue(v)
+	}
+
+	qs.main, qs.helper = qs.helper, qs.main
+}
+
+// Pop removes and returns the top element from the stack.
+// Returns an error if the stack is empty.
+// Time complexity: O(1)
+func (qs *QueueStack[T]) Pop() (T, error) {
+	var zero T
+
+	if qs.main.IsEmpty() {
+		return zero, fmt.Errorf("stack: %w", ErrEmpty)
+	}
+
+	return qs.main.Dequeue()
+}
+
+// Peek returns the top element without removing it.
+// Returns an error if the stack is empty.
+// Time complexity: O(1)
+func (qs *QueueStack[T]) Peek() (T, error) {
+	var zero T
+
+	if qs.main.IsEmpty() {
+		return zero, fmt.Errorf("stack: %w", ErrEmpty)
+	}
+
+	return qs.main.Front()
+}
+
+// Size returns the number of elements in the stack.
+// Time complexity: O(1)
+func (qs *QueueStack[T]) Size() int {
+	return qs.main.Size()
+}
+
+// IsEmpty returns true if the stack is empty.
+// Time complexity: O(1)
+func (qs *QueueStack[T]) IsEmpty() bool {
+	return qs.main.IsEmpty()
+}
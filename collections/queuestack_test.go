@@ -0,0 +1,68 @@
+package collections
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewQueueStack(t *testing.T) {
+	qs := NewQueueStack[int]()
+
+	if qs.Size() != 0 {
+		t.Errorf("expected size 0, got %d", qs.Size())
+	}
+
+	if !qs.IsEmpty() {
+		t.Error("expected empty stack")
+	}
+
+	if _, err := qs.Pop(); err == nil {
+		t.Error("expected error when popping empty stack")
+	}
+
+	if _, err := qs.Peek(); err == nil {
+		t.Error("expected error when peeking empty stack")
+	}
+}
+
+func TestQueueStackLIFO(t *testing.T) {
+	qs := NewQueueStack[int]()
+
+	for i := 1; i <= 4; i++ {
+		qs.Push(i)
+
+		// After every push the helper is empty and the main queue holds everything
+		if !qs.helper.IsEmpty() {
+			t.Errorf("expected empty helper queue after push %d, got %v", i, qs.helper.ToSlice())
+		}
+		if qs.main.Size() != i {
+			t.Errorf("expected main queue size %d, got %d", i, qs.main.Size())
+		}
+
+		top, err := qs.Peek()
+		if err != nil || top != i {
+			t.Errorf("expected top=%d, got %d, error=%v", i, top, err)
+		}
+	}
+
+	// Main queue front-to-rear is top-to-bottom
+	if !reflect.DeepEqual(qs.main.ToSlice(), []int{4, 3, 2, 1}) {
+		t.Errorf("expected main queue [4 3 2 1], got %v", qs.main.ToSlice())
+	}
+
+	var result []int
+	v, _ := qs.Pop()
+	result = append(result, v)
+
+	qs.Push(5)
+
+	for !qs.IsEmpty() {
+		v, _ = qs.Pop()
+		result = append(result, v)
+	}
+
+	expected := []int{4, 5, 3, 2, 1}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected LIFO order %v, got %v", expected, result)
+	}
+}
@@ -0,0 +1,37 @@
+package collections
+
+import (
+	"iter"
+	"math/rand/v2"
+)
+
+// ReservoirSample returns k elements chosen uniformly at random from seq using Algorithm R.
+// The stream is consumed once and may be arbitrarily long; only k elements are kept in memory.
+// If the stream yields fewer than k elements, all of them are returned in stream order.
+// A non-positive k returns an empty sample. rng supplies the randomness so results are
+// reproducible with a fixed seed.
+// Time complexity: O(n), Space complexity: O(k)
+func ReservoirSample[T any](seq iter.Seq[T], k int, rng *rand.Rand) []T {
+	if k <= 0 {
+		return []T{}
+	}
+
+	reservoir := make([]T, 0, k)
+	seen := 0
+
+	for value := range seq {
+		seen++
+
+		if len(reservoir) < k {
+			reservoir = append(reservoir, value)
+			continue
+		}
+
+		// Replace a random slot with probability k/seen
+		if j := rng.IntN(seen); j < k {
+			reservoir[j] = value
+		}
+	}
+
+	return reservoir
+}
@@ -0,0 +1,86 @@
+package collections
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// drainSeq yields the queue's elements by dequeuing them, modelling a one-shot stream.
+func drainSeq[T any](q *Queue[T]) func(yield func(T) bool) {
+	return func(yield func(T) bool) {
+		for value, ok := q.Poll(); ok; value, ok = q.Poll() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+func TestReservoirSampleFixedSeed(t *testing.T) {
+	stream := FromSliceQueue([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
+	rng := rand.New(rand.NewPCG(42, 7))
+
+	result := ReservoirSample(drainSeq(stream), 3, rng)
+
+	expected := []int{10, 4, 5}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+
+	if !stream.IsEmpty() {
+		t.Error("expected the stream to be fully consumed")
+	}
+}
+
+func TestReservoirSampleShortStream(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 1))
+
+	result := ReservoirSample(slices.Values([]string{"a", "b"}), 5, rng)
+
+	expected := []string{"a", "b"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestReservoirSampleNonPositiveK(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 1))
+
+	for _, k := range []int{0, -1} {
+		result := ReservoirSample(slices.Values([]int{1, 2, 3}), k, rng)
+		if len(result) != 0 {
+			t.Errorf("expected empty sample for k=%d, got %v", k, result)
+		}
+	}
+}
+
+func TestReservoirSampleUniform(t *testing.T) {
+	// Every element should be picked roughly k/n of the time
+	const (
+		n      = 10
+		k      = 2
+		trials = 20000
+	)
+
+	rng := rand.New(rand.NewPCG(3, 5))
+	data := make([]int, n)
+	for i := range data {
+		data[i] = i
+	}
+
+	counts := make([]int, n)
+	for i := 0; i < trials; i++ {
+		for _, v := range ReservoirSample(slices.Values(data), k, rng) {
+			counts[v]++
+		}
+	}
+
+	expected := trials * k / n
+	for v, c := range counts {
+		if c < expected*9/10 || c > expected*11/10 {
+			t.Errorf("element %d picked %d times, expected about %d", v, c, expected)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package collections
+
+import "iter"
+
+// Frequency counts how many times each element occurs in a collection.
+// Works with any collection exposing an All iterator, such as Queue, Stack, Deque and LinkedList.
+// Time complexity: O(n)
+func Frequency[T comparable, C interface{ All() iter.Seq[T] }](c C) map[T]int {
+	counts := make(map[T]int)
+
+	for value := range c.All() {
+		counts[value]++
+	}
+
+	return counts
+}
+
+// GroupBy groups the elements of seq by the key computed for each one.
+// Within each group, elements keep the order in which seq yielded them.
+// Pass a collection's All iterator to group its contents, e.g. GroupBy(q.All(), key).
+// Time complexity: O(n)
+func GroupBy[T any, K comparable](seq iter.Seq[T], key func(T) K) map[K][]T {
+	groups := make(map[K][]T)
+
+	for value := range seq {
+		k := key(value)
+		groups[k] = append(groups[k], value)
+	}
+
+	return groups
+}
+
+// Distinct returns an iterator that yields only the first occurrence of each element of seq,
+// preserving the order in which seq yields them. Iteration is lazy, so it stops pulling from
+// seq as soon as the consumer stops.
+// Pass a collection's All iterator to deduplicate its contents, e.g. Distinct(q.All()).
+// Time complexity: O(n), with O(d) extra space for d distinct elements
+func Distinct[T comparable](seq iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		seen := make(map[T]struct{})
+
+		for value := range seq {
+			if _, ok := seen[value]; ok {
+				continue
+			}
+			seen[value] = struct{}{}
+
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package collections
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestFrequency(t *testing.T) {
+	t.Run("queue", func(t *testing.T) {
+		q := FromSliceQueue([]string{"a", "b", "a", "c", "a", "b"})
+
+		expected := map[string]int{"a": 3, "b": 2, "c": 1}
+		if result := Frequency(q); !reflect.DeepEqual(result, expected) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("stack", func(t *testing.T) {
+		s := FromSliceStack([]int{7, 7, 7, 1})
+
+		expected := map[int]int{7: 3, 1: 1}
+		if result := Frequency(s); !reflect.DeepEqual(result, expected) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("deque and list", func(t *testing.T) {
+		expected := map[int]int{1: 2, 2: 1}
+
+		if result := Frequency(FromSliceDeque([]int{1, 2, 1})); !reflect.DeepEqual(result, expected) {
+			t.Errorf("expected %v from deque, got %v", expected, result)
+		}
+
+		if result := Frequency(FromSlice([]int{1, 2, 1})); !reflect.DeepEqual(result, expected) {
+			t.Errorf("expected %v from list, got %v", expected, result)
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		result := Frequency(NewQueue[int]())
+		if result == nil || len(result) != 0 {
+			t.Errorf("expected empty non-nil map, got %v", result)
+		}
+	})
+}
+
+func TestGroupBy(t *testing.T) {
+	t.Run("numbers by parity", func(t *testing.T) {
+		q := QueueOf(1, 2, 3, 4, 5, 6, 7)
+		groups := GroupBy(q.All(), func(v int) bool { return v%2 == 0 })
+
+		expected := map[bool][]int{false: {1, 3, 5, 7}, true: {2, 4, 6}}
+		if !reflect.DeepEqual(groups, expected) {
+			t.Errorf("expected %v, got %v", expected, groups)
+		}
+	})
+
+	t.Run("strings by first letter", func(t *testing.T) {
+		s := StackOf("apple", "banana", "avocado", "blueberry", "cherry")
+		groups := GroupBy(s.All(), func(v string) byte { return v[0] })
+
+		// Stack.All yields bottom to top
+		expected := map[byte][]string{
+			'a': {"apple", "avocado"},
+			'b': {"banana", "blueberry"},
+			'c': {"cherry"},
+		}
+		if !reflect.DeepEqual(groups, expected) {
+			t.Errorf("expected %v, got %v", expected, groups)
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		groups := GroupBy(NewDeque[int]().All(), func(v int) int { return v })
+		if groups == nil || len(groups) != 0 {
+			t.Errorf("expected empty non-nil map, got %v", groups)
+		}
+	})
+}
+
+func TestDistinct(t *testing.T) {
+	t.Run("queue with duplicates", func(t *testing.T) {
+		q := QueueOf(3, 1, 3, 2, 1, 4, 2)
+
+		var result []int
+		for value := range Distinct(q.All()) {
+			result = append(result, value)
+		}
+
+		expected := []int{3, 1, 2, 4}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+
+		if q.Size() != 7 {
+			t.Errorf("expected queue to be unchanged, got size %d", q.Size())
+		}
+	})
+
+	t.Run("into a collection", func(t *testing.T) {
+		s := StackOf("a", "b", "a", "c", "b")
+		result := FromSliceDeque(slices.Collect(Distinct(s.All())))
+
+		expected := []string{"a", "b", "c"}
+		if !reflect.DeepEqual(result.ToSlice(), expected) {
+			t.Errorf("expected %v, got %v", expected, result.ToSlice())
+		}
+	})
+
+	t.Run("early stop", func(t *testing.T) {
+		var result []int
+		for value := range Distinct(DequeOf(1, 1, 2, 3).All()) {
+			result = append(result, value)
+			if value == 2 {
+				break
+			}
+		}
+
+		expected := []int{1, 2}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		for value := range Distinct(NewQueue[int]().All()) {
+			t.Errorf("expected no values, got %v", value)
+		}
+	})
+}
@@ -0,0 +1,108 @@
+package collections
+
+import (
+	"iter"
+	"maps"
+)
+
+// Set represents an unordered collection of distinct values.
+// Implemented using a hash map; iteration order is unspecified.
+type Set[T comparable] struct {
+	items map[T]struct{}
+}
+
+// NewSet creates and returns a new empty set.
+func NewSet[T comparable]() *Set[T] {
+	return &Set[T]{
+		items: make(map[T]struct{}),
+	}
+}
+
+// SetFromSlice creates a new set holding the distinct values of slice.
+// Duplicates in slice are stored once.
+func SetFromSlice[T comparable](slice []T) *Set[T] {
+	s := &Set[T]{
+		items: make(map[T]struct{}, len(slice)),
+	}
+	for _, v := range slice {
+		s.items[v] = struct{}{}
+	}
+	return s
+}
+
+// SetOf creates a new set holding the distinct values passed, equivalent to SetFromSlice(values).
+func SetOf[T comparable](values ...T) *Set[T] {
+	return SetFromSlice(values)
+}
+
+// ToSet collects the distinct elements of a collection into a new Set.
+// Works with any collection exposing an All iterator, such as Queue, Stack, Deque and LinkedList.
+// Time complexity: O(n)
+func ToSet[T comparable, C interface{ All() iter.Seq[T] }](c C) *Set[T] {
+	s := NewSet[T]()
+	for value := range c.All() {
+		s.items[value] = struct{}{}
+	}
+	return s
+}
+
+// Add inserts value and returns true, or returns false if it was already present.
+// Time complexity: O(1)
+func (s *Set[T]) Add(value T) bool {
+	if _, ok := s.items[value]; ok {
+		return false
+	}
+	s.items[value] = struct{}{}
+	return true
+}
+
+// Remove deletes value and returns true if it was present.
+// Time complexity: O(1)
+func (s *Set[T]) Remove(value T) bool {
+	if _, ok := s.items[value]; !ok {
+		return false
+	}
+	delete(s.items, value)
+	return true
+}
+
+// Contains checks if the set contains the specified value.
+// Time complexity: O(1)
+func (s *Set[T]) Contains(value T) bool {
+	_, ok := s.items[value]
+	return ok
+}
+
+// Size returns the number of values in the set.
+// Time complexity: O(1)
+func (s *Set[T]) Size() int {
+	return len(s.items)
+}
+
+// IsEmpty returns true if the set is empty.
+// Time complexity: O(1)
+func (s *Set[T]) IsEmpty() bool {
+	return len(s.items) == 0
+}
+
+// Clear removes all values from the set.
+// Time complexity: O(n)
+func (s *Set[T]) Clear() {
+	clear(s.items)
+}
+
+// ToSlice returns the values of the set as a slice in unspecified order.
+// Time complexity: O(n)
+func (s *Set[T]) ToSlice() []T {
+	result := make([]T, 0, len(s.items))
+	for v := range s.items {
+		result = append(result, v)
+	}
+	return result
+}
+
+// All returns an iterator over the values of the set in unspecified order.
+// The set must not be modified during iteration.
+func (s *Set[T]) All() iter.Seq[T] {
+	return maps.Keys(s.items)
+}
@@ -0,0 +1,97 @@
+package collections
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestNewSet(t *testing.T) {
+	s := NewSet[int]()
+
+	if s.Size() != 0 || !s.IsEmpty() {
+		t.Error("expected empty set")
+	}
+
+	if !s.Add(1) || s.Add(1) {
+		t.Error("expected first add to succeed and the duplicate to fail")
+	}
+
+	if !s.Contains(1) || s.Contains(2) {
+		t.Error("unexpected membership")
+	}
+
+	if !s.Remove(1) || s.Remove(1) {
+		t.Error("expected first remove to succeed and the second to fail")
+	}
+
+	s.Add(5)
+	s.Clear()
+	if !s.IsEmpty() {
+		t.Errorf("expected empty set after Clear, got size %d", s.Size())
+	}
+}
+
+func TestSetFromSlice(t *testing.T) {
+	s := SetFromSlice([]string{"a", "b", "a", "c", "b"})
+
+	if s.Size() != 3 {
+		t.Errorf("expected size 3, got %d", s.Size())
+	}
+
+	got := s.ToSlice()
+	slices.Sort(got)
+	if !reflect.DeepEqual(got, []string{"a", "b", "c"}) {
+		t.Errorf("expected [a b c], got %v", got)
+	}
+
+	fromAll := slices.Sorted(s.All())
+	if !reflect.DeepEqual(fromAll, []string{"a", "b", "c"}) {
+		t.Errorf("expected All to yield [a b c], got %v", fromAll)
+	}
+
+	if !SetFromSlice[int](nil).IsEmpty() {
+		t.Error("expected empty set from nil slice")
+	}
+}
+
+func TestToSet(t *testing.T) {
+	t.Run("queue with duplicates", func(t *testing.T) {
+		s := ToSet(QueueOf(3, 1, 3, 2, 1, 3))
+
+		if s.Size() != 3 {
+			t.Errorf("expected 3 distinct elements, got %d", s.Size())
+		}
+
+		for _, v := range []int{1, 2, 3} {
+			if !s.Contains(v) {
+				t.Errorf("expected set to contain %d", v)
+			}
+		}
+
+		if s.Contains(4) {
+			t.Error("expected set not to contain 4")
+		}
+	})
+
+	t.Run("other collections", func(t *testing.T) {
+		expected := []string{"a", "b"}
+
+		for name, s := range map[string]*Set[string]{
+			"stack": ToSet(StackOf("a", "b", "a")),
+			"deque": ToSet(DequeOf("b", "a", "b")),
+			"list":  ToSet(ListOf("a", "a", "b")),
+			"SetOf": SetOf("b", "b", "a"),
+		} {
+			if got := slices.Sorted(s.All()); !reflect.DeepEqual(got, expected) {
+				t.Errorf("%s: expected %v, got %v", name, expected, got)
+			}
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		if !ToSet(NewQueue[int]()).IsEmpty() {
+			t.Error("expected empty set from empty queue")
+		}
+	})
+}
@@ -0,0 +1,28 @@
+package collections
+
+// ReverseSlice reverses the elements of s in place.
+// The collections use it for their own reversal of contiguous storage.
+// Time complexity: O(n)
+func ReverseSlice[T any](s []T) {
+	for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+		s[i], s[j] = s[j], s[i]
+	}
+}
+
+// ChunkSlice splits s into consecutive batches of at most n elements, in order.
+// The last batch may be smaller. Batches share s's backing array but are capped,
+// so appending to one never overwrites the next. Returns nil if n is not positive.
+// Time complexity: O(len(s)/n)
+func ChunkSlice[T any](s []T, n int) [][]T {
+	if n <= 0 {
+		return nil
+	}
+
+	result := make([][]T, 0, (len(s)+n-1)/n)
+	for start := 0; start < len(s); start += n {
+		end := min(start+n, len(s))
+		result = append(result, s[start:end:end])
+	}
+
+	return result
+}
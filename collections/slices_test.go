@@ -0,0 +1,66 @@
+package collections
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestReverseSlice(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		expected []int
+	}{
+		{"empty", []int{}, []int{}},
+		{"nil", nil, nil},
+		{"single element", []int{1}, []int{1}},
+		{"even length", []int{1, 2, 3, 4}, []int{4, 3, 2, 1}},
+		{"odd length", []int{1, 2, 3}, []int{3, 2, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ReverseSlice(tt.input)
+
+			if !reflect.DeepEqual(tt.input, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, tt.input)
+			}
+		})
+	}
+}
+
+func TestChunkSlice(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		n        int
+		expected [][]int
+	}{
+		{"uneven", []int{1, 2, 3, 4, 5}, 2, [][]int{{1, 2}, {3, 4}, {5}}},
+		{"even", []int{1, 2, 3, 4}, 2, [][]int{{1, 2}, {3, 4}}},
+		{"single element", []int{1}, 3, [][]int{{1}}},
+		{"n larger than slice", []int{1, 2, 3}, 10, [][]int{{1, 2, 3}}},
+		{"n of one", []int{1, 2}, 1, [][]int{{1}, {2}}},
+		{"empty", []int{}, 3, [][]int{}},
+		{"zero n", []int{1, 2}, 0, nil},
+		{"negative n", []int{1, 2}, -1, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := ChunkSlice(tt.input, tt.n); !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+
+	t.Run("append does not overwrite next chunk", func(t *testing.T) {
+		input := []int{1, 2, 3, 4}
+		chunks := ChunkSlice(input, 2)
+		_ = append(chunks[0], 99)
+
+		if !reflect.DeepEqual(chunks[1], []int{3, 4}) {
+			t.Errorf("expected second chunk [3 4], got %v", chunks[1])
+		}
+	})
+}
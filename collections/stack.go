@@ -2,6 +2,8 @@ package collections
 
 import (
 	"fmt"
+	"iter"
+	"slices"
 	"strings"
 )
 
@@ -28,6 +30,7 @@ func NewStackWithCapacity[T any](capacity int) *Stack[T] {
 
 // FromSliceStack creates a new stack from a slice.
 // The first element of the slice becomes the bottom of the stack.
+// Use FromSliceStackReversed when the first element should be on top.
 func FromSliceStack[T any](slice []T) *Stack[T] {
 	items := make([]T, len(slice))
 	copy(items, slice)
@@ -36,12 +39,52 @@ func FromSliceStack[T any](slice []T) *Stack[T] {
 	}
 }
 
+// FromSliceStackReversed creates a new stack from a slice whose first element becomes
+// the top of the stack, so popping returns elements in slice order. This is the reverse
+// of FromSliceStack, where the first element is at the bottom and is popped last.
+// Time complexity: O(n)
+func FromSliceStackReversed[T any](slice []T) *Stack[T] {
+	items := make([]T, len(slice))
+	copy(items, slice)
+	ReverseSlice(items)
+	return &Stack[T]{
+		items: items,
+	}
+}
+
+// StackOf creates a new stack holding values, equivalent to FromSliceStack(values).
+// The first value becomes the bottom of the stack.
+func StackOf[T any](values ...T) *Stack[T] {
+	return FromSliceStack(values)
+}
+
+// FromSeqStack creates a new stack holding every element yielded by seq, in order.
+// Time complexity: O(n)
+func FromSeqStack[T any](seq iter.Seq[T]) *Stack[T] {
+	s := NewStack[T]()
+	s.PushSeq(seq)
+	return s
+}
+
 // Push adds an element to the top of the stack.
 // Time complexity: O(1) amortized
 func (s *Stack[T]) Push(value T) {
 	s.items = append(s.items, value)
 }
 
+// PushIfAbsent pushes value only if an equal element is not already present.
+// Returns true if the value was pushed. Equality uses the same comparison as Contains,
+// so each call scans the whole stack.
+// Time complexity: O(n)
+func (s *Stack[T]) PushIfAbsent(value T) bool {
+	if s.Contains(value) {
+		return false
+	}
+
+	s.Push(value)
+	return true
+}
+
 // Pop removes and returns the top element from the stack.
 // Returns an error if the stack is empty.
 // Time complexity: O(1)
@@ -49,7 +92,7 @@ func (s *Stack[T]) Pop() (T, error) {
 	var zero T
 
 	if len(s.items) == 0 {
-		return zero, fmt.Errorf("stack is empty")
+		return zero, fmt.Errorf("stack: %w", ErrEmpty)
 	}
 
 	index := len(s.items) - 1
@@ -59,6 +102,40 @@ func (s *Stack[T]) Pop() (T, error) {
 	return value, nil
 }
 
+// TryPop removes and returns the top element from the stack.
+// Returns the zero value and false if the stack is empty, avoiding the
+// error allocation of Pop in tight loops.
+// Time complexity: O(1)
+func (s *Stack[T]) TryPop() (T, bool) {
+	if len(s.items) == 0 {
+		var zero T
+		return zero, false
+	}
+
+	value, _ := s.Pop()
+	return value, true
+}
+
+// DupTop pushes a copy of the top element, Forth's DUP.
+// Returns an error if the stack is empty.
+// Time complexity: O(1) amortized
+func (s *Stack[T]) DupTop() error {
+	if len(s.items) == 0 {
+		return fmt.Errorf("stack: %w", ErrEmpty)
+	}
+
+	s.items = append(s.items, s.items[len(s.items)-1])
+	return nil
+}
+
+// DropTop removes the top element and discards it, Forth's DROP.
+// Returns an error if the stack is empty.
+// Time complexity: O(1)
+func (s *Stack[T]) DropTop() error {
+	_, err := s.Pop()
+	return err
+}
+
 // Peek returns the top element without removing it.
 // Returns an error if the stack is empty.
 // Time complexity: O(1)
@@ -66,7 +143,7 @@ func (s *Stack[T]) Peek() (T, error) {
 	var zero T
 
 	if len(s.items) == 0 {
-		return zero, fmt.Errorf("stack is empty")
+		return zero, fmt.Errorf("stack: %w", ErrEmpty)
 	}
 
 	return s.items[len(s.items)-1], nil
@@ -99,6 +176,38 @@ func (s *Stack[T]) ToSlice() []T {
 	return result
 }
 
+// ToReversedSlice returns a copy of the stack as a slice without modifying the stack.
+// The first element is the top of the stack, last element is the bottom.
+// Time complexity: O(n)
+func (s *Stack[T]) ToReversedSlice() []T {
+	result := s.ToSlice()
+	ReverseSlice(result)
+	return result
+}
+
+// All returns an iterator over the elements from bottom to top, matching ToSlice.
+// The stack must not be modified during iteration.
+func (s *Stack[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, item := range s.items {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// ForEachUntil calls f for each element from top to bottom, stopping as soon as f
+// returns false. It does not allocate.
+// Time complexity: O(n)
+func (s *Stack[T]) ForEachUntil(f func(T) bool) {
+	for i := len(s.items) - 1; i >= 0; i-- {
+		if !f(s.items[i]) {
+			return
+		}
+	}
+}
+
 // Contains checks if the stack contains the specified value.
 // Time complexity: O(n)
 func (s *Stack[T]) Contains(value T) bool {
@@ -110,9 +219,72 @@ func (s *Stack[T]) Contains(value T) bool {
 	return false
 }
 
+// CountValue returns the number of elements equal to value, compared the same way as Contains.
+// Time complexity: O(n)
+func (s *Stack[T]) CountValue(value T) int {
+	count := 0
+	for _, item := range s.items {
+		if isEqual(item, value) {
+			count++
+		}
+	}
+	return count
+}
+
+// ContainsFunc reports whether any element satisfies pred.
+// Time complexity: O(n)
+func (s *Stack[T]) ContainsFunc(pred func(T) bool) bool {
+	return s.IndexFromTop(pred) >= 0
+}
+
+// IndexFromTop returns the distance from the top of the nearest element satisfying pred
+// (0 is the top), or -1 if no element matches. The search starts at the top.
+// Time complexity: O(n)
+func (s *Stack[T]) IndexFromTop(pred func(T) bool) int {
+	for i := len(s.items) - 1; i >= 0; i-- {
+		if pred(s.items[i]) {
+			return len(s.items) - 1 - i
+		}
+	}
+	return -1
+}
+
 // String returns a string representation of the stack.
 // Shows elements from bottom to top.
 func (s *Stack[T]) String() string {
+	return s.format("%v")
+}
+
+// GoString returns a string representation of the stack with Go-syntax quoted elements,
+// so string elements containing separators remain unambiguous. Implements fmt.GoStringer.
+func (s *Stack[T]) GoString() string {
+	return s.format("%#v")
+}
+
+// StringTopFirst returns a string representation of the stack with elements from top to
+// bottom, for debugging where the top matters most. The empty stack renders as "Stack[]".
+// Time complexity: O(n)
+func (s *Stack[T]) StringTopFirst() string {
+	if len(s.items) == 0 {
+		return "Stack[]"
+	}
+
+	var sb strings.Builder
+	sb.WriteString("(top) Stack[")
+
+	for i := len(s.items) - 1; i >= 0; i-- {
+		if i < len(s.items)-1 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("%v", s.items[i]))
+	}
+
+	sb.WriteString("]")
+	return sb.String()
+}
+
+// format renders the stack from bottom to top using verb for each element.
+func (s *Stack[T]) format(verb string) string {
 	if len(s.items) == 0 {
 		return "Stack[]"
 	}
@@ -124,14 +296,16 @@ func (s *Stack[T]) String() string {
 		if i > 0 {
 			sb.WriteString(", ")
 		}
-		sb.WriteString(fmt.Sprintf("%v", item))
+		sb.WriteString(fmt.Sprintf(verb, item))
 	}
 
 	sb.WriteString("] (top)")
 	return sb.String()
 }
 
-// Clone creates a deep copy of the stack.
+// Clone creates a copy of the stack with its own backing slice.
+// The copy is shallow: elements are copied by assignment, so pointer elements share
+// their pointees with the original. Use CloneWith to copy elements deeply.
 // Time complexity: O(n)
 func (s *Stack[T]) Clone() *Stack[T] {
 	clone := NewStackWithCapacity[T](len(s.items))
@@ -140,12 +314,41 @@ func (s *Stack[T]) Clone() *Stack[T] {
 	return clone
 }
 
+// CloneWith creates a copy of the stack whose elements are produced by copyElem,
+// preserving bottom-to-top order. Pass a function that duplicates each element to
+// deep-copy pointer or reference elements.
+// Time complexity: O(n) calls to copyElem
+func (s *Stack[T]) CloneWith(copyElem func(T) T) *Stack[T] {
+	clone := NewStackWithCapacity[T](cap(s.items))
+	for _, value := range s.items {
+		clone.items = append(clone.items, copyElem(value))
+	}
+	return clone
+}
+
+// CloneMap creates a copy of the stack with f applied to each element.
+// CloneMap is equivalent to CloneWith(f).
+// Time complexity: O(n) calls to f
+func (s *Stack[T]) CloneMap(f func(T) T) *Stack[T] {
+	return s.CloneWith(f)
+}
+
 // Capacity returns the current capacity of the underlying slice.
 // This can be useful for memory optimization analysis.
 func (s *Stack[T]) Capacity() int {
 	return cap(s.items)
 }
 
+// Grow ensures the stack has room for at least n more pushes without reallocating.
+// A non-positive n is a no-op.
+// Time complexity: O(n) when reallocating, otherwise O(1)
+func (s *Stack[T]) Grow(n int) {
+	if n <= 0 {
+		return
+	}
+	s.items = slices.Grow(s.items, n)
+}
+
 // MultiPush pushes multiple elements onto the stack.
 // Elements are pushed in order, so the last element will be at the top.
 // Time complexity: O(n) where n is the number of elements
@@ -153,17 +356,26 @@ func (s *Stack[T]) MultiPush(values ...T) {
 	s.items = append(s.items, values...)
 }
 
+// PushSeq pushes every element yielded by seq onto the stack, in order,
+// so the last yielded element ends up at the top.
+// Time complexity: O(n) amortized where n is the number of elements yielded
+func (s *Stack[T]) PushSeq(seq iter.Seq[T]) {
+	for value := range seq {
+		s.items = append(s.items, value)
+	}
+}
+
 // MultiPop pops n elements from the stack and returns them in reverse order.
 // The first element in the returned slice is the top of the stack.
 // Returns an error if there aren't enough elements.
 // Time complexity: O(n)
 func (s *Stack[T]) MultiPop(n int) ([]T, error) {
 	if n < 0 {
-		return nil, fmt.Errorf("cannot pop negative number of elements: %d", n)
+		return nil, fmt.Errorf("%w: cannot pop negative number of elements: %d", ErrInvalidArgument, n)
 	}
 
 	if n > len(s.items) {
-		return nil, fmt.Errorf("cannot pop %d elements from stack of size %d", n, len(s.items))
+		return nil, fmt.Errorf("%w: cannot pop %d elements from stack of size %d", ErrInsufficientElements, n, len(s.items))
 	}
 
 	if n == 0 {
@@ -173,10 +385,8 @@ func (s *Stack[T]) MultiPop(n int) ([]T, error) {
 	// Get the elements in reverse order (top to bottom)
 	result := make([]T, n)
 	start := len(s.items) - n
-
-	for i := 0; i < n; i++ {
-		result[i] = s.items[start+n-1-i]
-	}
+	copy(result, s.items[start:])
+	ReverseSlice(result)
 
 	// Remove the elements from the stack
 	s.items = s.items[:start]
@@ -190,11 +400,11 @@ func (s *Stack[T]) MultiPop(n int) ([]T, error) {
 // Time complexity: O(n)
 func (s *Stack[T]) PeekN(n int) ([]T, error) {
 	if n < 0 {
-		return nil, fmt.Errorf("cannot peek negative number of elements: %d", n)
+		return nil, fmt.Errorf("%w: cannot peek negative number of elements: %d", ErrInvalidArgument, n)
 	}
 
 	if n > len(s.items) {
-		return nil, fmt.Errorf("cannot peek %d elements from stack of size %d", n, len(s.items))
+		return nil, fmt.Errorf("%w: cannot peek %d elements from stack of size %d", ErrInsufficientElements, n, len(s.items))
 	}
 
 	if n == 0 {
@@ -203,10 +413,8 @@ func (s *Stack[T]) PeekN(n int) ([]T, error) {
 
 	result := make([]T, n)
 	start := len(s.items) - n
-
-	for i := 0; i < n; i++ {
-		result[i] = s.items[start+n-1-i]
-	}
+	copy(result, s.items[start:])
+	ReverseSlice(result)
 
 	return result, nil
 }
@@ -215,7 +423,97 @@ func (s *Stack[T]) PeekN(n int) ([]T, error) {
 // The bottom becomes the top and vice versa.
 // Time complexity: O(n)
 func (s *Stack[T]) Reverse() {
-	for i, j := 0, len(s.items)-1; i < j; i, j = i+1, j-1 {
-		s.items[i], s.items[j] = s.items[j], s.items[i]
+	ReverseSlice(s.items)
+}
+
+// MapInPlace replaces every element with f applied to it, bottom to top,
+// reusing the existing backing slice.
+// Time complexity: O(n)
+func (s *Stack[T]) MapInPlace(f func(T) T) {
+	for i, value := range s.items {
+		s.items[i] = f(value)
+	}
+}
+
+// MoveToQueue removes all elements from the stack and returns them as a queue.
+// Elements are moved as if popped one by one and enqueued, so the top of the stack
+// becomes the front of the queue and the bottom becomes the rear.
+// The stack becomes empty after this operation.
+// Time complexity: O(n)
+func (s *Stack[T]) MoveToQueue() *Queue[T] {
+	q := FromSliceQueue(s.ToReversedSlice())
+	s.Clear()
+	return q
+}
+
+// ToQueue returns a new queue holding the stack's elements in pop order, leaving the
+// stack unchanged. The top of the stack becomes the front of the queue and the bottom
+// becomes the rear, so for a stack built by pushing 1, 2, 3 the queue dequeues 3, 2, 1.
+// This is the non-draining counterpart of MoveToQueue.
+// Time complexity: O(n)
+func (s *Stack[T]) ToQueue() *Queue[T] {
+	return FromSliceQueue(s.ToReversedSlice())
+}
+
+// defaultBracketPairs maps each opener to its closer for IsBalanced.
+var defaultBracketPairs = map[rune]rune{'(': ')', '[': ']', '{': '}'}
+
+// IsBalanced reports whether every bracket in s is closed in the right order.
+// pairs maps each opening rune to its closing rune; nil means (), [] and {}.
+// Each closer must match the most recently opened bracket, stray closers fail,
+// and any opener left unclosed at the end fails. Other runes are ignored. A rune
+// paired with itself, such as '|', closes when it matches the innermost open
+// bracket and opens otherwise.
+// Time complexity: O(n) where n is the length of s
+func IsBalanced(s string, pairs map[rune]rune) bool {
+	if pairs == nil {
+		pairs = defaultBracketPairs
+	}
+
+	closers := make(map[rune]bool, len(pairs))
+	for _, closer := range pairs {
+		closers[closer] = true
 	}
+
+	// Holds the closer expected for each open bracket
+	expected := NewStack[rune]()
+	for _, char := range s {
+		// Try closing first so a rune paired with itself can close its own bracket
+		if closers[char] {
+			if top, err := expected.Peek(); err == nil && top == char {
+				expected.Pop()
+				continue
+			}
+			if _, opens := pairs[char]; !opens {
+				return false
+			}
+		}
+
+		if closer, ok := pairs[char]; ok {
+			expected.Push(closer)
+		}
+	}
+
+	return expected.IsEmpty()
+}
+
+// FoldStack combines the elements of s from bottom to top into a single value,
+// starting from init and applying f to the running result and each element.
+// Time complexity: O(n)
+func FoldStack[T, R any](s *Stack[T], init R, f func(R, T) R) R {
+	result := init
+	for _, value := range s.items {
+		result = f(result, value)
+	}
+	return result
+}
+
+// FoldStackReverse is FoldStack in pop order, from top to bottom.
+// Time complexity: O(n)
+func FoldStackReverse[T, R any](s *Stack[T], init R, f func(R, T) R) R {
+	result := init
+	for i := len(s.items) - 1; i >= 0; i-- {
+		result = f(result, s.items[i])
+	}
+	return result
 }
@@ -2,6 +2,7 @@ package collections
 
 import (
 	"reflect"
+	"slices"
 	"testing"
 )
 
@@ -52,6 +53,50 @@ func TestFromSliceStack(t *testing.T) {
 	}
 }
 
+func TestStackOf(t *testing.T) {
+	// Construction is covered by TestListOf; this checks the last value is the top
+	if top, err := StackOf(1, 2, 3).Peek(); err != nil || top != 3 {
+		t.Errorf("expected top 3, got %d, error=%v", top, err)
+	}
+}
+
+func TestFromSliceStackReversed(t *testing.T) {
+	input := []int{1, 2, 3, 4}
+	s := FromSliceStackReversed(input)
+
+	var popped []int
+	for !s.IsEmpty() {
+		v, _ := s.Pop()
+		popped = append(popped, v)
+	}
+
+	if !reflect.DeepEqual(popped, input) {
+		t.Errorf("expected pop order %v, got %v", input, popped)
+	}
+
+	// Contrast with FromSliceStack, which pops in reverse slice order
+	if top, _ := FromSliceStack(input).Peek(); top != 4 {
+		t.Errorf("expected FromSliceStack top 4, got %d", top)
+	}
+
+	if top, _ := FromSliceStackReversed(input).Peek(); top != 1 {
+		t.Errorf("expected FromSliceStackReversed top 1, got %d", top)
+	}
+
+	if !FromSliceStackReversed([]int{}).IsEmpty() {
+		t.Error("expected empty stack from empty slice")
+	}
+}
+
+func TestFromSeqStack(t *testing.T) {
+	// Sequence handling is covered by TestFromSeq; this checks the last value is the top
+	c := FromSeqStack(slices.Values([]int{1, 2, 3}))
+
+	if value, err := c.Pop(); err != nil || value != 3 {
+		t.Errorf("expected 3 from Pop, got %d, error=%v", value, err)
+	}
+}
+
 func TestPush(t *testing.T) {
 	s := NewStack[int]()
 
@@ -84,6 +129,25 @@ func TestPush(t *testing.T) {
 	}
 }
 
+func TestPushIfAbsent(t *testing.T) {
+	s := NewStack[int]()
+
+	for _, v := range []int{1, 2, 3} {
+		if !s.PushIfAbsent(v) {
+			t.Errorf("expected %d to be pushed", v)
+		}
+	}
+
+	if s.PushIfAbsent(2) {
+		t.Error("expected duplicate 2 to be rejected")
+	}
+
+	expected := []int{1, 2, 3}
+	if !reflect.DeepEqual(s.ToSlice(), expected) {
+		t.Errorf("expected %v, got %v", expected, s.ToSlice())
+	}
+}
+
 func TestPop(t *testing.T) {
 	s := FromSliceStack([]int{1, 2, 3})
 
@@ -135,6 +199,22 @@ func TestPeek(t *testing.T) {
 	}
 }
 
+func TestTryPop(t *testing.T) {
+	s := FromSliceStack([]int{1, 2})
+
+	value, ok := s.TryPop()
+	if !ok || value != 2 {
+		t.Errorf("expected value=2, ok=true, got %d, %t", value, ok)
+	}
+
+	s.TryPop()
+
+	value, ok = s.TryPop()
+	if ok || value != 0 {
+		t.Errorf("expected zero value and ok=false, got %d, %t", value, ok)
+	}
+}
+
 func TestMultiPush(t *testing.T) {
 	s := NewStack[int]()
 
@@ -154,6 +234,22 @@ func TestMultiPush(t *testing.T) {
 	}
 }
 
+func TestPushSeq(t *testing.T) {
+	s := NewStack[int]()
+	s.PushSeq(slices.Values([]int{1, 2, 3}))
+
+	expected := []int{1, 2, 3}
+	if !reflect.DeepEqual(s.ToSlice(), expected) {
+		t.Errorf("expected %v, got %v", expected, s.ToSlice())
+	}
+
+	// Last yielded element is on top
+	top, _ := s.Peek()
+	if top != 3 {
+		t.Errorf("expected top=3, got %d", top)
+	}
+}
+
 func TestMultiPop(t *testing.T) {
 	s := FromSliceStack([]int{1, 2, 3, 4, 5})
 
@@ -285,6 +381,34 @@ func TestStackClone(t *testing.T) {
 	}
 }
 
+func TestStackCloneWith(t *testing.T) {
+	s := FromSliceStack([][]int{{1}, {2, 3}})
+	clone := s.CloneWith(slices.Clone[[]int])
+
+	top, _ := clone.Peek()
+	top[0] = 100
+
+	original, _ := s.Peek()
+	if original[0] != 2 {
+		t.Errorf("expected original element unchanged, got %v", original)
+	}
+
+	expected := [][]int{{1}, {100, 3}}
+	if !reflect.DeepEqual(clone.ToSlice(), expected) {
+		t.Errorf("expected %v, got %v", expected, clone.ToSlice())
+	}
+}
+
+func TestStackCloneMap(t *testing.T) {
+	double := func(v int) int { return v * 2 }
+	c := StackOf(1, 2, 3)
+
+	result, expected := c.CloneMap(double).ToSlice(), c.CloneWith(double).ToSlice()
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
 func TestStackReverse(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -310,6 +434,91 @@ func TestStackReverse(t *testing.T) {
 	}
 }
 
+func TestStackToReversedSlice(t *testing.T) {
+	tests := []struct {
+		name    string
+		initial []int
+	}{
+		{"empty stack", []int{}},
+		{"single element", []int{1}},
+		{"multiple elements", []int{1, 2, 3, 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := FromSliceStack(tt.initial)
+			result := s.ToReversedSlice()
+
+			reference := s.Clone()
+			reference.Reverse()
+
+			if !reflect.DeepEqual(result, reference.ToSlice()) {
+				t.Errorf("expected %v, got %v", reference.ToSlice(), result)
+			}
+
+			// Original must be unchanged
+			if !reflect.DeepEqual(s.ToSlice(), tt.initial) {
+				t.Errorf("expected stack unchanged %v, got %v", tt.initial, s.ToSlice())
+			}
+		})
+	}
+}
+
+func TestMoveToQueue(t *testing.T) {
+	s := FromSliceStack([]int{1, 2, 3})
+	q := s.MoveToQueue()
+
+	if !s.IsEmpty() {
+		t.Error("expected stack to be drained")
+	}
+
+	// Top ends at the front, so dequeue order matches pop order
+	expected := []int{3, 2, 1}
+	result := q.DrainTo()
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected dequeue order %v, got %v", expected, result)
+	}
+
+	empty := NewStack[int]().MoveToQueue()
+	if !empty.IsEmpty() {
+		t.Error("expected empty queue from empty stack")
+	}
+}
+
+func TestStackToQueue(t *testing.T) {
+	s := StackOf(1, 2, 3)
+	q := s.ToQueue()
+
+	expected := []int{3, 2, 1}
+	if result := q.DrainTo(); !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected dequeue order %v, got %v", expected, result)
+	}
+
+	if !reflect.DeepEqual(s.ToSlice(), []int{1, 2, 3}) {
+		t.Errorf("expected stack unchanged, got %v", s.ToSlice())
+	}
+
+	// The queue does not share storage with the stack
+	q.Enqueue(9)
+	if s.Size() != 3 {
+		t.Errorf("expected stack size 3, got %d", s.Size())
+	}
+
+	if !NewStack[int]().ToQueue().IsEmpty() {
+		t.Error("expected empty queue from empty stack")
+	}
+}
+
+func TestStackAll(t *testing.T) {
+	s := FromSliceStack([]int{1, 2, 3})
+
+	result := slices.Collect(s.All())
+	if !reflect.DeepEqual(result, []int{1, 2, 3}) {
+		t.Errorf("expected bottom-to-top [1 2 3], got %v", result)
+	}
+}
+
 func TestStackString(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -333,6 +542,55 @@ func TestStackString(t *testing.T) {
 	}
 }
 
+func TestStackStringTopFirst(t *testing.T) {
+	tests := []struct {
+		name        string
+		initial     []int
+		bottomFirst string
+		topFirst    string
+	}{
+		{"empty stack", []int{}, "Stack[]", "Stack[]"},
+		{"single element", []int{10}, "Stack[10] (top)", "(top) Stack[10]"},
+		{"multiple elements", []int{110, 111, 112}, "Stack[110, 111, 112] (top)", "(top) Stack[112, 111, 110]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := FromSliceStack(tt.initial)
+
+			if result := s.String(); result != tt.bottomFirst {
+				t.Errorf("expected %s, got %s", tt.bottomFirst, result)
+			}
+
+			if result := s.StringTopFirst(); result != tt.topFirst {
+				t.Errorf("expected %s, got %s", tt.topFirst, result)
+			}
+		})
+	}
+}
+
+func TestStackGoString(t *testing.T) {
+	tests := []struct {
+		name     string
+		initial  []string
+		expected string
+	}{
+		{"empty stack", []string{}, "Stack[]"},
+		{"comma inside element", []string{"a, b", "c"}, `Stack["a, b", "c"] (top)`},
+		{"brackets inside element", []string{"[]"}, `Stack["[]"] (top)`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := FromSliceStack(tt.initial)
+
+			if result := s.GoString(); result != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, result)
+			}
+		})
+	}
+}
+
 func TestStackOperationsOrder(t *testing.T) {
 	s := NewStack[int]()
 
@@ -354,6 +612,203 @@ func TestStackOperationsOrder(t *testing.T) {
 	}
 }
 
+func TestStackDupTopDropTop(t *testing.T) {
+	s := FromSliceStack([]int{1, 2})
+
+	if err := s.DupTop(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if s.Size() != 3 {
+		t.Errorf("expected size 3, got %d", s.Size())
+	}
+
+	if top, _ := s.Peek(); top != 2 {
+		t.Errorf("expected top 2, got %d", top)
+	}
+
+	if !reflect.DeepEqual(s.ToSlice(), []int{1, 2, 2}) {
+		t.Errorf("expected [1 2 2], got %v", s.ToSlice())
+	}
+
+	for i := 0; i < 3; i++ {
+		if err := s.DropTop(); err != nil {
+			t.Fatalf("unexpected error on drop %d: %v", i, err)
+		}
+	}
+
+	if err := s.DupTop(); err == nil {
+		t.Error("expected error when duplicating top of empty stack")
+	}
+
+	if err := s.DropTop(); err == nil {
+		t.Error("expected error when dropping top of empty stack")
+	}
+}
+
+func TestIsBalanced(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		pairs    map[rune]rune
+		expected bool
+	}{
+		{"empty", "", nil, true},
+		{"nested mixed", "{[()()]}", nil, true},
+		{"with other text", "f(a[0], {b: 1})", nil, true},
+		{"mismatched pair", "([)]", nil, false},
+		{"wrong closer", "(]", nil, false},
+		{"unclosed opener", "({}", nil, false},
+		{"stray closer", "())", nil, false},
+		{"closer first", ")(", nil, false},
+		{"custom angle brackets", "<a<b>>", map[rune]rune{'<': '>'}, true},
+		{"custom unbalanced", "<<>", map[rune]rune{'<': '>'}, false},
+		{"custom ignores defaults", "<(>", map[rune]rune{'<': '>'}, true},
+		{"self-paired closes", "|a|", map[rune]rune{'|': '|'}, true},
+		{"self-paired nested", "(|x|)", map[rune]rune{'(': ')', '|': '|'}, true},
+		{"self-paired unclosed", "|a|b|", map[rune]rune{'|': '|'}, false},
+		{"self-paired crossing", "|(|)", map[rune]rune{'(': ')', '|': '|'}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsBalanced(tt.input, tt.pairs); got != tt.expected {
+				t.Errorf("expected %v for %q, got %v", tt.expected, tt.input, got)
+			}
+		})
+	}
+}
+
+func TestStackGrow(t *testing.T) {
+	s := StackOf(1, 2)
+
+	s.Grow(1000)
+	capacity := s.Capacity()
+	if capacity < 1002 {
+		t.Fatalf("expected capacity of at least 1002, got %d", capacity)
+	}
+
+	for i := 0; i < 1000; i++ {
+		s.Push(i)
+	}
+
+	if s.Capacity() != capacity {
+		t.Errorf("expected capacity to stay %d, got %d", capacity, s.Capacity())
+	}
+
+	if !reflect.DeepEqual(s.ToSlice()[:3], []int{1, 2, 0}) || s.Size() != 1002 {
+		t.Errorf("expected contents preserved, got %v... (size %d)", s.ToSlice()[:3], s.Size())
+	}
+
+	s.Grow(-1)
+	if s.Capacity() != capacity {
+		t.Errorf("expected capacity to stay %d, got %d", capacity, s.Capacity())
+	}
+}
+
+func TestStackMapInPlace(t *testing.T) {
+	s := StackOf(1, 2, 3)
+	buffer := &s.items[0]
+
+	s.MapInPlace(func(v int) int { return v + 1 })
+
+	if !reflect.DeepEqual(s.ToSlice(), []int{2, 3, 4}) {
+		t.Errorf("expected [2 3 4], got %v", s.ToSlice())
+	}
+
+	if &s.items[0] != buffer {
+		t.Error("expected the backing slice to be reused")
+	}
+}
+
+func TestStackForEachUntil(t *testing.T) {
+	// Stopping behaviour is covered by TestQueueForEachUntil; this checks top-first order
+	var visited []int
+	StackOf(3, 2, 1).ForEachUntil(func(v int) bool {
+		visited = append(visited, v)
+		return v != 2
+	})
+
+	if !reflect.DeepEqual(visited, []int{1, 2}) {
+		t.Errorf("expected visited %v, got %v", []int{1, 2}, visited)
+	}
+}
+
+func TestStackIndexFromTop(t *testing.T) {
+	type frame struct {
+		name string
+		line int
+	}
+
+	s := StackOf(
+		frame{"main", 10},
+		frame{"parse", 42},
+		frame{"lex", 7},
+		frame{"parse", 99},
+	)
+
+	tests := []struct {
+		name     string
+		target   string
+		expected int
+	}{
+		{"top", "parse", 0},
+		{"middle", "lex", 1},
+		{"bottom", "main", 3},
+		{"absent", "eval", -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pred := func(f frame) bool { return f.name == tt.target }
+
+			if got := s.IndexFromTop(pred); got != tt.expected {
+				t.Errorf("expected index %d, got %d", tt.expected, got)
+			}
+
+			if got := s.ContainsFunc(pred); got != (tt.expected >= 0) {
+				t.Errorf("expected ContainsFunc=%v, got %v", tt.expected >= 0, got)
+			}
+		})
+	}
+}
+
+func TestStackCountValue(t *testing.T) {
+	// The full value table lives in TestCountValue; this checks pops are reflected
+	s := StackOf(1, 2, 1)
+	s.Pop()
+
+	if got := s.CountValue(1); got != 1 {
+		t.Errorf("expected 1 occurrence of 1 after pop, got %d", got)
+	}
+}
+
+func TestFoldStack(t *testing.T) {
+	s := StackOf(1, 2, 3, 4)
+	sum := func(acc, v int) int { return acc + v }
+
+	if got := FoldStack(s, 0, sum); got != 10 {
+		t.Errorf("expected sum 10, got %d", got)
+	}
+
+	if got := FoldStackReverse(s, 0, sum); got != 10 {
+		t.Errorf("expected reverse sum 10, got %d", got)
+	}
+
+	// Order-sensitive folds show the traversal direction
+	appendDigit := func(acc []int, v int) []int { return append(acc, v) }
+	if got := FoldStack(s, []int{}, appendDigit); !reflect.DeepEqual(got, []int{1, 2, 3, 4}) {
+		t.Errorf("expected bottom-to-top [1 2 3 4], got %v", got)
+	}
+	if got := FoldStackReverse(s, []int{}, appendDigit); !reflect.DeepEqual(got, []int{4, 3, 2, 1}) {
+		t.Errorf("expected top-to-bottom [4 3 2 1], got %v", got)
+	}
+
+	if got := FoldStack(NewStack[int](), 7, sum); got != 7 {
+		t.Errorf("expected init 7 for empty stack, got %d", got)
+	}
+}
+
 // Benchmark tests
 func BenchmarkPush(b *testing.B) {
 	s := NewStack[int]()
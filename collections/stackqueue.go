@@ -0,0 +1,79 @@
+package collections
+
+import "fmt"
+
+// StackQueue represents a First-In-First-Out (FIFO) queue built from two stacks.
+// Elements are pushed onto an inbox stack; when the outbox stack is empty, the whole
+// inbox is popped onto it, reversing the order so the oldest element is on top.
+// Each element is moved at most once, giving amortized O(1) operations.
+type StackQueue[T any] struct {
+	inbox  *Stack[T] // Receives newly enqueued elements
+	outbox *Stack[T] // Holds elements in dequeue order (oldest on top)
+}
+
+// NewStackQueue creates and returns a new empty two-stack queue.
+func NewStackQueue[T any]() *StackQueue[T] {
+	return &StackQueue[T]{
+		inbox:  NewStack[T](),
+		outbox: NewStack[T](),
+	}
+}
+
+// Enqueue adds an element to the rear of the queue.
+// Time complexity: O(1) amortized
+func (sq *StackQueue[T]) Enqueue(value T) {
+	sq.inbox.Push(value)
+}
+
+// Dequeue removes and returns the front element from the queue.
+// Returns an error if the queue is empty.
+// Time complexity: O(1) amortized
+func (sq *StackQueue[T]) Dequeue() (T, error) {
+	var zero T
+
+	if sq.IsEmpty() {
+		return zero, fmt.Errorf("queue: %w", ErrEmpty)
+	}
+
+	sq.transfer()
+	return sq.outbox.Pop()
+}
+
+// Front returns the front element without removing it.
+// Returns an error if the queue is empty.
+// Time complexity: O(1) amortized
+func (sq *StackQueue[T]) Front() (T, error) {
+	var zero T
+
+	if sq.IsEmpty() {
+		return zero, fmt.Errorf("queue: %w", ErrEmpty)
+	}
+
+	sq.transfer()
+	return sq.outbox.Peek()
+}
+
+// Size returns the number of elements in the queue.
+// Time complexity: O(1)
+func (sq *StackQueue[T]) Size() int {
+	return sq.inbox.Size() + sq.outbox.Size()
+}
+
+// IsEmpty returns true if the queue is empty.
+// Time complexity: O(1)
+func (sq *StackQueue[T]) IsEmpty() bool {
+	return sq.inbox.IsEmpty() && sq.outbox.IsEmpty()
+}
+
+// transfer moves every inbox element onto the outbox, but only when the outbox is empty.
+// Moving earlier would bury older elements beneath newer ones.
+func (sq *StackQueue[T]) transfer() {
+	if !sq.outbox.IsEmpty() {
+		return
+	}
+
+	for !sq.inbox.IsEmpty() {
+		value, _ := sq.inbox.Pop()
+		sq.outbox.Push(value)
+	}
+}
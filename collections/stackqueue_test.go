@@ -0,0 +1,65 @@
+package collections
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewStackQueue(t *testing.T) {
+	sq := NewStackQueue[int]()
+
+	if sq.Size() != 0 {
+		t.Errorf("expected size 0, got %d", sq.Size())
+	}
+
+	if !sq.IsEmpty() {
+		t.Error("expected empty queue")
+	}
+
+	if _, err := sq.Dequeue(); err == nil {
+		t.Error("expected error when dequeuing empty queue")
+	}
+
+	if _, err := sq.Front(); err == nil {
+		t.Error("expected error when reading front of empty queue")
+	}
+}
+
+func TestStackQueueFIFO(t *testing.T) {
+	sq := NewStackQueue[int]()
+	var result []int
+
+	// Interleave operations so transfers happen while the inbox is non-empty
+	sq.Enqueue(1)
+	sq.Enqueue(2)
+	sq.Enqueue(3)
+
+	v, _ := sq.Dequeue() // Transfers 1,2,3 to the outbox
+	result = append(result, v)
+
+	sq.Enqueue(4)
+	sq.Enqueue(5)
+
+	front, err := sq.Front()
+	if err != nil || front != 2 {
+		t.Errorf("expected front=2, got %d, error=%v", front, err)
+	}
+
+	if sq.Size() != 4 {
+		t.Errorf("expected size 4, got %d", sq.Size())
+	}
+
+	for !sq.IsEmpty() {
+		v, _ = sq.Dequeue()
+		result = append(result, v)
+		if v == 3 {
+			// Outbox now empty; this element must wait behind 4 and 5
+			sq.Enqueue(6)
+		}
+	}
+
+	expected := []int{1, 2, 3, 4, 5, 6}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected FIFO order %v, got %v", expected, result)
+	}
+}
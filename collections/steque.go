@@ -0,0 +1,53 @@
+package collections
+
+// Steque represents a stack-ended queue: elements can be pushed onto the front like a
+// stack or enqueued at the back like a queue, but are only ever removed from the front.
+// Backed by a Deque, so every operation is O(1) amortized.
+type Steque[T any] struct {
+	deque *Deque[T]
+}
+
+// NewSteque creates and returns a new empty steque.
+func NewSteque[T any]() *Steque[T] {
+	return &Steque[T]{
+		deque: NewDeque[T](),
+	}
+}
+
+// Push adds an element to the front, so it is the next to be popped.
+// Time complexity: O(1) amortized
+func (sq *Steque[T]) Push(value T) {
+	sq.deque.PushFront(value)
+}
+
+// Enqueue adds an element to the back, so it is popped after everything already present.
+// Time complexity: O(1) amortized
+func (sq *Steque[T]) Enqueue(value T) {
+	sq.deque.PushBack(value)
+}
+
+// Pop removes and returns the front element.
+// Returns an error wrapping ErrEmpty if the steque is empty.
+// Time complexity: O(1)
+func (sq *Steque[T]) Pop() (T, error) {
+	return sq.deque.PopFront()
+}
+
+// Peek returns the front element without removing it.
+// Returns an error wrapping ErrEmpty if the steque is empty.
+// Time complexity: O(1)
+func (sq *Steque[T]) Peek() (T, error) {
+	return sq.deque.Front()
+}
+
+// Size returns the number of elements in the steque.
+// Time complexity: O(1)
+func (sq *Steque[T]) Size() int {
+	return sq.deque.Size()
+}
+
+// IsEmpty returns true if the steque is empty.
+// Time complexity: O(1)
+func (sq *Steque[T]) IsEmpty() bool {
+	return sq.deque.IsEmpty()
+}
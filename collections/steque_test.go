@@ -0,0 +1,53 @@
+package collections
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestNewSteque(t *testing.T) {
+	sq := NewSteque[int]()
+
+	if sq.Size() != 0 || !sq.IsEmpty() {
+		t.Error("expected empty steque")
+	}
+
+	if _, err := sq.Pop(); !errors.Is(err, ErrEmpty) {
+		t.Errorf("expected ErrEmpty when popping empty steque, got %v", err)
+	}
+
+	if _, err := sq.Peek(); !errors.Is(err, ErrEmpty) {
+		t.Errorf("expected ErrEmpty when peeking empty steque, got %v", err)
+	}
+}
+
+func TestStequeOrder(t *testing.T) {
+	sq := NewSteque[int]()
+
+	sq.Enqueue(3)
+	sq.Push(2)
+	sq.Enqueue(4)
+	sq.Push(1)
+	sq.Enqueue(5)
+
+	if top, _ := sq.Peek(); top != 1 {
+		t.Errorf("expected front 1, got %d", top)
+	}
+
+	if sq.Size() != 5 {
+		t.Errorf("expected size 5, got %d", sq.Size())
+	}
+
+	var result []int
+	for !sq.IsEmpty() {
+		v, _ := sq.Pop()
+		result = append(result, v)
+	}
+
+	// Pushed elements come out first, newest first; enqueued ones follow in FIFO order
+	expected := []int{1, 2, 3, 4, 5}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
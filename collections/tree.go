@@ -0,0 +1,93 @@
+package collections
+
+// TreeNode represents a single node in a binary tree.
+type TreeNode[T any] struct {
+	Value T
+	Left  *TreeNode[T]
+	Right *TreeNode[T]
+}
+
+// InOrderIter returns the values of the tree in in-order (left, node, right) sequence.
+// Uses an explicit Stack instead of recursion, so deep trees cannot overflow the call stack.
+// Time complexity: O(n)
+func InOrderIter[T any](root *TreeNode[T]) []T {
+	result := make([]T, 0)
+	stack := NewStack[*TreeNode[T]]()
+	current := root
+
+	for current != nil || !stack.IsEmpty() {
+		// Walk as far left as possible
+		for current != nil {
+			stack.Push(current)
+			current = current.Left
+		}
+
+		node, _ := stack.Pop()
+		result = append(result, node.Value)
+		current = node.Right
+	}
+
+	return result
+}
+
+// PreOrderIter returns the values of the tree in pre-order (node, left, right) sequence.
+// Uses an explicit Stack instead of recursion.
+// Time complexity: O(n)
+func PreOrderIter[T any](root *TreeNode[T]) []T {
+	result := make([]T, 0)
+	if root == nil {
+		return result
+	}
+
+	stack := NewStack[*TreeNode[T]]()
+	stack.Push(root)
+
+	for !stack.IsEmpty() {
+		node, _ := stack.Pop()
+		result = append(result, node.Value)
+
+		// Push right first so the left subtree is processed first
+		if node.Right != nil {
+			stack.Push(node.Right)
+		}
+		if node.Left != nil {
+			stack.Push(node.Left)
+		}
+	}
+
+	return result
+}
+
+// PostOrderIter returns the values of the tree in post-order (left, right, node) sequence.
+// Uses two explicit Stacks instead of recursion.
+// Time complexity: O(n)
+func PostOrderIter[T any](root *TreeNode[T]) []T {
+	result := make([]T, 0)
+	if root == nil {
+		return result
+	}
+
+	stack := NewStack[*TreeNode[T]]()
+	output := NewStack[T]()
+	stack.Push(root)
+
+	// Produce node, right, left order, which reversed is left, right, node
+	for !stack.IsEmpty() {
+		node, _ := stack.Pop()
+		output.Push(node.Value)
+
+		if node.Left != nil {
+			stack.Push(node.Left)
+		}
+		if node.Right != nil {
+			stack.Push(node.Right)
+		}
+	}
+
+	for !output.IsEmpty() {
+		value, _ := output.Pop()
+		result = append(result, value)
+	}
+
+	return result
+}
@@ -0,0 +1,125 @@
+package collections
+
+import (
+	"reflect"
+	"testing"
+)
+
+// buildTestTree builds the tree:
+//
+//	     1
+//	   /   \
+//	  2     3
+//	 / \     \
+//	4   5     6
+//	   /
+//	  7
+func buildTestTree() *TreeNode[int] {
+	return &TreeNode[int]{
+		Value: 1,
+		Left: &TreeNode[int]{
+			Value: 2,
+			Left:  &TreeNode[int]{Value: 4},
+			Right: &TreeNode[int]{Value: 5, Left: &TreeNode[int]{Value: 7}},
+		},
+		Right: &TreeNode[int]{
+			Value: 3,
+			Right: &TreeNode[int]{Value: 6},
+		},
+	}
+}
+
+func inOrderRecursive(node *TreeNode[int], result *[]int) {
+	if node == nil {
+		return
+	}
+	inOrderRecursive(node.Left, result)
+	*result = append(*result, node.Value)
+	inOrderRecursive(node.Right, result)
+}
+
+func preOrderRecursive(node *TreeNode[int], result *[]int) {
+	if node == nil {
+		return
+	}
+	*result = append(*result, node.Value)
+	preOrderRecursive(node.Left, result)
+	preOrderRecursive(node.Right, result)
+}
+
+func postOrderRecursive(node *TreeNode[int], result *[]int) {
+	if node == nil {
+		return
+	}
+	postOrderRecursive(node.Left, result)
+	postOrderRecursive(node.Right, result)
+	*result = append(*result, node.Value)
+}
+
+func TestTreeTraversals(t *testing.T) {
+	root := buildTestTree()
+
+	tests := []struct {
+		name      string
+		iterative func(*TreeNode[int]) []int
+		recursive func(*TreeNode[int], *[]int)
+		expected  []int
+	}{
+		{"in-order", InOrderIter[int], inOrderRecursive, []int{4, 2, 7, 5, 1, 3, 6}},
+		{"pre-order", PreOrderIter[int], preOrderRecursive, []int{1, 2, 4, 5, 7, 3, 6}},
+		{"post-order", PostOrderIter[int], postOrderRecursive, []int{4, 7, 5, 2, 6, 3, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reference := []int{}
+			tt.recursive(root, &reference)
+
+			result := tt.iterative(root)
+
+			if !reflect.DeepEqual(result, reference) {
+				t.Errorf("expected recursive order %v, got %v", reference, result)
+			}
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestTreeTraversalsEmpty(t *testing.T) {
+	traversals := map[string]func(*TreeNode[int]) []int{
+		"in-order":   InOrderIter[int],
+		"pre-order":  PreOrderIter[int],
+		"post-order": PostOrderIter[int],
+	}
+
+	for name, traverse := range traversals {
+		t.Run(name, func(t *testing.T) {
+			result := traverse(nil)
+			if len(result) != 0 {
+				t.Errorf("expected empty result, got %v", result)
+			}
+		})
+	}
+}
+
+func TestTreeTraversalsDeepTree(t *testing.T) {
+	// A degenerate left-leaning tree that would be deep for naive recursion
+	const depth = 10000
+	var root *TreeNode[int]
+	for i := depth; i > 0; i-- {
+		root = &TreeNode[int]{Value: i, Left: root}
+	}
+
+	result := InOrderIter(root)
+	if len(result) != depth {
+		t.Fatalf("expected %d values, got %d", depth, len(result))
+	}
+
+	// Leftmost (deepest) node is visited first
+	if result[0] != depth || result[depth-1] != 1 {
+		t.Errorf("expected order %d..1, got first=%d last=%d", depth, result[0], result[depth-1])
+	}
+}
@@ -68,7 +68,7 @@ func main() {
 	fmt.Println("1. Balanced Parentheses Check:")
 	expressions := []string{"()", "([{}])", "({[})", "((()))", "({[}]"}
 	for _, expr := range expressions {
-		balanced := isBalanced(expr)
+		balanced := collections.IsBalanced(expr, nil)
 		fmt.Printf("'%s' is balanced: %t\n", expr, balanced)
 	}
 
@@ -85,28 +85,6 @@ func main() {
 	fmt.Printf("Postfix %v = %d\n", postfix, result)
 }
 
-// Common interview problem: Check if parentheses are balanced
-func isBalanced(s string) bool {
-	stack := collections.NewStack[rune]()
-	pairs := map[rune]rune{')': '(', ']': '[', '}': '{'}
-
-	for _, char := range s {
-		switch char {
-		case '(', '[', '{':
-			stack.Push(char)
-		case ')', ']', '}':
-			if stack.IsEmpty() {
-				return false
-			}
-			if top, err := stack.Pop(); err != nil || top != pairs[char] {
-				return false
-			}
-		}
-	}
-
-	return stack.IsEmpty()
-}
-
 // Reverse a string using stack
 func reverseString(s string) string {
 	stack := collections.NewStack[rune]()